@@ -26,6 +26,7 @@ type GitDaemon struct {
 	isGitCommitLogPassiveRunning        atomic.Bool
 	isGitStashPassiveRunning            atomic.Bool
 	isGitRemoteSyncStatusActiveRunning  atomic.Bool
+	isGitRepoOperationPassiveRunning    atomic.Bool
 	watcherTimer                        *time.Timer
 	gitFilesActiveTimer                 *time.Timer
 	gitRemoteSyncStatusActiveTimer      *time.Timer
@@ -69,6 +70,7 @@ func InitGitDaemon(absoluteGitPath string, updateChannel chan string, gitOperati
 	gd.isGitBranchPassiveRunning.Store(false)
 	gd.isGitCommitLogPassiveRunning.Store(false)
 	gd.isGitStashPassiveRunning.Store(false)
+	gd.isGitRepoOperationPassiveRunning.Store(false)
 	gd.watcherTimer.Stop()
 	gd.gitFilesActiveTimer.Stop()
 	gd.gitRemoteSyncStatusActiveTimer.Stop()
@@ -192,6 +194,13 @@ func (gd *GitDaemon) gitLatestInfoFetch(needFetch bool) {
 			gd.updateChannel <- git.GIT_STASH_UPDATE
 		}
 	}()
+	go func() {
+		if gd.isGitRepoOperationPassiveRunning.CompareAndSwap(false, true) {
+			defer gd.isGitRepoOperationPassiveRunning.Store(false)
+			gd.gitOperations.GitRepoOperation.GetLatestRepoOperationState()
+			gd.updateChannel <- git.GIT_REPO_OPERATION_STATE_UPDATE
+		}
+	}()
 }
 
 func (gd *GitDaemon) isRelevantEvent(event fsnotify.Event) bool {
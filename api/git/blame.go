@@ -0,0 +1,120 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gohyuhan/gitti/executor"
+)
+
+// a line of the file with the commit that last touched it
+type BlameLine struct {
+	Hash            string
+	Author          string
+	AuthorTime      time.Time
+	Summary         string
+	OrigLineNumber  int  // the line number within the commit that last touched it
+	FinalLineNumber int  // the line number within the current file
+	IsUncommitted   bool // the line was changed in the working tree and not committed yet
+	Content         string
+}
+
+type GitBlame struct {
+	errorLog       []error
+	gitProcessLock *GitProcessLock
+}
+
+type blameCommitInfo struct {
+	author     string
+	authorTime time.Time
+	summary    string
+}
+
+func InitGitBlame(gitProcessLock *GitProcessLock) *GitBlame {
+	gitBlame := &GitBlame{
+		errorLog:       []error{},
+		gitProcessLock: gitProcessLock,
+	}
+
+	return gitBlame
+}
+
+// ----------------------------------
+//
+//	Get the blame of a file on the working tree
+//	* return nil when the file can't be blamed (eg, untracked file, binary file)
+//
+// ----------------------------------
+func (gb *GitBlame) GetFileBlame(ctx context.Context, filePathName string) []BlameLine {
+	gitArgs := []string{"blame", "--porcelain", "--", filePathName}
+
+	cmdExecutor := executor.GittiCmdExecutor.RunGitCmdWithContext(ctx, gitArgs, false)
+	gitOutput, err := cmdExecutor.Output()
+	if err != nil {
+		if ctx.Err() != nil {
+			gb.errorLog = append(gb.errorLog, fmt.Errorf("[GIT BLAME OPERATION CANCELLED DUE TO CONTEXT SWITCHING]: %w", ctx.Err()))
+			return nil
+		}
+		gb.errorLog = append(gb.errorLog, fmt.Errorf("[GIT BLAME ERROR]: %w", err))
+		return nil
+	}
+
+	return parseBlamePorcelain(string(gitOutput))
+}
+
+// ----------------------------------
+//
+//	Parse the output of git blame --porcelain
+//	* every line start with a header "<hash> <orig line> <final line> [<lines in group>]",
+//	  followed by the commit info (only on the first time the commit appear) and the content prefixed with a tab
+//
+// ----------------------------------
+func parseBlamePorcelain(output string) []BlameLine {
+	blameLines := []BlameLine{}
+	commitsInfo := make(map[string]*blameCommitInfo)
+	var currentLine *BlameLine
+
+	for line := range strings.SplitSeq(output, "\n") {
+		if currentLine == nil {
+			fields := strings.Fields(line)
+			if len(fields) < 3 {
+				continue
+			}
+			currentLine = &BlameLine{
+				Hash:            fields[0],
+				OrigLineNumber:  atoiOrDefault(fields[1], 0),
+				FinalLineNumber: atoiOrDefault(fields[2], 0),
+				IsUncommitted:   strings.Trim(fields[0], "0") == "",
+			}
+			if _, exist := commitsInfo[currentLine.Hash]; !exist {
+				commitsInfo[currentLine.Hash] = &blameCommitInfo{}
+			}
+			continue
+		}
+
+		commitInfo := commitsInfo[currentLine.Hash]
+		key, value, _ := strings.Cut(line, " ")
+		switch {
+		case strings.HasPrefix(line, "\t"):
+			currentLine.Content = line[1:]
+			currentLine.Author = commitInfo.author
+			currentLine.AuthorTime = commitInfo.authorTime
+			currentLine.Summary = commitInfo.summary
+			blameLines = append(blameLines, *currentLine)
+			currentLine = nil
+		case key == "author":
+			commitInfo.author = value
+		case key == "author-time":
+			if unixTime, err := strconv.ParseInt(value, 10, 64); err == nil {
+				commitInfo.authorTime = time.Unix(unixTime, 0)
+			}
+		case key == "summary":
+			commitInfo.summary = value
+		}
+	}
+
+	return blameLines
+}
@@ -0,0 +1,92 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/gohyuhan/gitti/executor"
+)
+
+// the flags of git clean for the options, without -n or -f
+func cleanOptionArgs(includeDirectories bool, ignoredMode string) []string {
+	args := []string{}
+	if includeDirectories {
+		args = append(args, "-d")
+	}
+	switch ignoredMode {
+	case CLEANIGNOREDINCLUDE:
+		args = append(args, "-x")
+	case CLEANIGNOREDONLY:
+		args = append(args, "-X")
+	}
+	return args
+}
+
+// ----------------------------------
+//
+//	Return what git clean would remove with the options, as a dry run (git clean -n)
+//	* directories are ended with "/", nested repositories are skipped by git clean and so are left out
+//
+// ----------------------------------
+func (gf *GitFiles) GitCleanPreview(includeDirectories bool, ignoredMode string) []string {
+	gitArgs := append([]string{"clean", "-n"}, cleanOptionArgs(includeDirectories, ignoredMode)...)
+	cmdExecutor := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+	// the output is parsed, so it should not be translated
+	cmdExecutor.Env = append(os.Environ(), "LC_ALL=C")
+	gitOutput, err := cmdExecutor.Output()
+	if err != nil {
+		gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT CLEAN PREVIEW ERROR]: %w", err))
+		return []string{}
+	}
+
+	entries := []string{}
+	for line := range strings.SplitSeq(string(gitOutput), "\n") {
+		entry, ok := strings.CutPrefix(line, "Would remove ")
+		if !ok || entry == "" {
+			continue
+		}
+		// paths with special characters are quoted in C style
+		if strings.HasPrefix(entry, `"`) {
+			if unquoted, err := strconv.Unquote(entry); err == nil {
+				entry = unquoted
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// ----------------------------------
+//
+//	Remove the given entries of the clean preview, with the same options that the preview was made with
+//	* the entries are passed as literal pathspecs, so only what was chosen will be removed
+//
+// ----------------------------------
+func (gf *GitFiles) GitCleanEntries(entries []string, includeDirectories bool, ignoredMode string) ([]string, bool) {
+	if !gf.gitProcessLock.CanProceedWithGitOps() {
+		return []string{}, false
+	}
+	defer gf.gitProcessLock.ReleaseGitOpsLock()
+
+	gitArgs := []string{"--literal-pathspecs", "clean", "-f"}
+	gitArgs = append(gitArgs, cleanOptionArgs(includeDirectories, ignoredMode)...)
+	gitArgs = append(gitArgs, "--")
+	gitArgs = append(gitArgs, entries...)
+	cmdExecutor := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+	gitOutput, err := cmdExecutor.CombinedOutput()
+	gitOpsOutput := processGeneralGitOpsOutputIntoStringArray(gitOutput)
+	success := true
+	if err != nil {
+		gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT CLEAN ERROR]: %w", err))
+		success = false
+	}
+
+	// the clean doesn't trigger any write in .git folder, so we trigger a fetch here to prevent a "lag" in the UI
+	go func() {
+		gf.GetGitFilesStatus()
+		gf.updateChannel <- GIT_FILES_STATUS_UPDATE
+	}()
+	return gitOpsOutput, success
+}
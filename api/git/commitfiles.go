@@ -0,0 +1,173 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/gohyuhan/gitti/executor"
+)
+
+// a parent of a commit, a merge commit can be diffed against any of them
+type CommitParent struct {
+	Hash    string
+	Message string
+}
+
+// a file changed by a commit against the parent that it was diffed against
+type CommitFile struct {
+	FilePathName string
+	OrigPath     string // the path before it was renamed or copied, empty otherwise
+	Status       string // the status letter of --name-status (eg, A, M, D, R, C or T)
+	Added        int
+	Removed      int
+	IsBinary     bool
+}
+
+// the path to be shown to the user, "orig -> path" for a renamed or copied file
+func (cF CommitFile) DisplayPathname() string {
+	if cF.OrigPath != "" {
+		return cF.OrigPath + " -> " + cF.FilePathName
+	}
+	return cF.FilePathName
+}
+
+// ----------------------------------
+//
+//	Return the parents of the commit in order, along with their subject
+//
+// ----------------------------------
+func (gCL *GitCommitLog) CommitParents(commitHash string) []CommitParent {
+	gitArgs := []string{"log", "--no-walk=unsorted", "--format=%H%x00%s", commitHash + "^@"}
+	cmd := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+	output, err := cmd.Output()
+	if err != nil {
+		gCL.errorLog = append(gCL.errorLog, fmt.Errorf("[GIT COMMIT PARENTS ERROR]: %w", err))
+		return []CommitParent{}
+	}
+
+	parents := []CommitParent{}
+	for line := range strings.SplitSeq(strings.TrimSpace(string(output)), "\n") {
+		hash, message, ok := strings.Cut(line, "\x00")
+		if !ok {
+			continue
+		}
+		parents = append(parents, CommitParent{Hash: hash, Message: message})
+	}
+	return parents
+}
+
+// ----------------------------------
+//
+//	Return the files changed by the commit against the parent, with the status from --name-status and the line counts from --numstat
+//	* an empty parent hash is for a root commit, the files are then diffed against the empty tree
+//
+// ----------------------------------
+func (gCL *GitCommitLog) CommitFiles(commitHash string, parentHash string, diffOptions DiffOptions) []CommitFile {
+	nameStatusArgs := append([]string{"diff-tree", "-r", "--no-commit-id", "--name-status", "-z"}, diffOptions.GitArgs()...)
+	cmd := executor.GittiCmdExecutor.RunGitCmd(append(nameStatusArgs, commitFilesRevisions(commitHash, parentHash)...), false)
+	nameStatusOutput, err := cmd.Output()
+	if err != nil {
+		gCL.errorLog = append(gCL.errorLog, fmt.Errorf("[GIT COMMIT FILES ERROR]: %w", err))
+		return []CommitFile{}
+	}
+
+	commitFiles := parseNameStatus(nameStatusOutput)
+	filesIndex := make(map[string]int, len(commitFiles))
+	for index, commitFile := range commitFiles {
+		filesIndex[commitFile.FilePathName] = index
+	}
+
+	numstatArgs := append([]string{"diff-tree", "-r", "--no-commit-id", "--numstat", "-z"}, diffOptions.GitArgs()...)
+	cmd = executor.GittiCmdExecutor.RunGitCmd(append(numstatArgs, commitFilesRevisions(commitHash, parentHash)...), false)
+	numstatOutput, err := cmd.Output()
+	if err != nil {
+		// the files are still listed, only without the line counts
+		gCL.errorLog = append(gCL.errorLog, fmt.Errorf("[GIT COMMIT FILES ERROR]: %w", err))
+		return commitFiles
+	}
+	for _, numstat := range parseNumstat(numstatOutput) {
+		index, ok := filesIndex[numstat.filePathName]
+		if !ok {
+			continue
+		}
+		commitFiles[index].Added = numstat.added
+		commitFiles[index].Removed = numstat.removed
+		commitFiles[index].IsBinary = numstat.isBinary
+	}
+	return commitFiles
+}
+
+// ----------------------------------
+//
+//	Return the diff of only the file, between the commit and the parent
+//	* both the paths of a renamed or copied file are given so git can still pair them up
+//
+// ----------------------------------
+func (gCL *GitCommitLog) CommitFileDiff(ctx context.Context, commitHash string, parentHash string, commitFile CommitFile, diffOptions DiffOptions) []string {
+	gitArgs := append([]string{"diff-tree", "-r", "--no-commit-id", "-p"}, diffOptions.PatchGitArgs()...)
+	gitArgs = append(gitArgs, commitFilesRevisions(commitHash, parentHash)...)
+	gitArgs = append(gitArgs, "--", commitFile.FilePathName)
+	if commitFile.OrigPath != "" {
+		gitArgs = append(gitArgs, commitFile.OrigPath)
+	}
+
+	cmdExecutor := executor.GittiCmdExecutor.RunGitCmdWithContext(ctx, gitArgs, true)
+	gitOutput, err := cmdExecutor.Output()
+	if err != nil {
+		if ctx.Err() != nil {
+			// This catches context.Canceled
+			gCL.errorLog = append(gCL.errorLog, fmt.Errorf("[COMMIT FILE DIFF OPERATION CANCELLED DUE TO CONTEXT SWITCHING]: %w", ctx.Err()))
+			return nil
+		}
+		exitError, ok := err.(*exec.ExitError)
+		if ok {
+			if exitError.ExitCode() != 1 {
+				gCL.errorLog = append(gCL.errorLog, fmt.Errorf("[GIT COMMIT FILE DIFF ERROR]: %w", err))
+				return nil
+			}
+		} else {
+			gCL.errorLog = append(gCL.errorLog, fmt.Errorf("[GIT COMMIT FILE DIFF ERROR]: %w", err))
+			return nil
+		}
+	}
+
+	return processGeneralGitOpsOutputIntoStringArray(gitOutput)
+}
+
+// the revisions for git diff-tree, the commit alone with --root will be diffed against the empty tree
+func commitFilesRevisions(commitHash string, parentHash string) []string {
+	if parentHash == "" {
+		return []string{"--root", commitHash}
+	}
+	return []string{parentHash, commitHash}
+}
+
+// ----------------------------------
+//
+//	Parse the output of --name-status -z, the renamed and copied file are followed by both their orig path and path
+//
+// ----------------------------------
+func parseNameStatus(gitOutput []byte) []CommitFile {
+	commitFiles := []CommitFile{}
+	fields := strings.Split(string(gitOutput), "\x00")
+	for index := 0; index+1 < len(fields); index++ {
+		status := fields[index]
+		if status == "" {
+			continue
+		}
+		commitFile := CommitFile{Status: status[:1], FilePathName: fields[index+1]}
+		index++
+		if commitFile.Status == "R" || commitFile.Status == "C" {
+			if index+1 >= len(fields) {
+				break
+			}
+			commitFile.OrigPath = commitFile.FilePathName
+			commitFile.FilePathName = fields[index+1]
+			index++
+		}
+		commitFiles = append(commitFiles, commitFile)
+	}
+	return commitFiles
+}
@@ -0,0 +1,221 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gohyuhan/gitti/executor"
+)
+
+// a conflict block within a conflicted file, between the <<<<<<< and >>>>>>> markers
+type ConflictBlock struct {
+	OursLabel   string // the text after <<<<<<< (eg, HEAD)
+	BaseLabel   string // the text after |||||||, only for diff3 or zdiff3 conflict style
+	TheirsLabel string // the text after >>>>>>> (eg, the merged branch name)
+	Ours        []string
+	Base        []string
+	Theirs      []string
+	HasBase     bool
+	RawLines    []string // the block as it is in the file, including the markers
+	Resolution  string
+}
+
+// a part of the conflicted file, either the lines outside of any conflict or a conflict block
+type ConflictSegment struct {
+	Lines []string
+	Block *ConflictBlock // nil when the segment is not a conflict block
+}
+
+type ConflictFile struct {
+	Segments []ConflictSegment
+}
+
+// ----------------------------------
+//
+//	Parse the conflict markers within the content of a conflicted file
+//	* the base section (|||||||) of diff3 or zdiff3 conflict style is supported
+//	* a block that was not closed properly will be kept as normal lines
+//
+// ----------------------------------
+func ParseConflictMarkers(content string) ConflictFile {
+	const (
+		outsideBlock = iota
+		inOurs
+		inBase
+		inTheirs
+	)
+
+	conflictFile := ConflictFile{Segments: []ConflictSegment{}}
+	plainLines := []string{}
+	var block *ConflictBlock
+	state := outsideBlock
+
+	flushPlainLines := func() {
+		if len(plainLines) > 0 {
+			conflictFile.Segments = append(conflictFile.Segments, ConflictSegment{Lines: plainLines})
+			plainLines = []string{}
+		}
+	}
+
+	for line := range strings.SplitSeq(content, "\n") {
+		if state == outsideBlock {
+			if label, ok := conflictMarkerLabel(line, "<<<<<<<"); ok {
+				block = &ConflictBlock{OursLabel: label, RawLines: []string{line}}
+				state = inOurs
+				continue
+			}
+			plainLines = append(plainLines, line)
+			continue
+		}
+
+		block.RawLines = append(block.RawLines, line)
+		if label, ok := conflictMarkerLabel(line, "|||||||"); ok && state == inOurs {
+			block.BaseLabel = label
+			block.HasBase = true
+			state = inBase
+			continue
+		}
+		if strings.TrimSuffix(line, "\r") == "=======" && (state == inOurs || state == inBase) {
+			state = inTheirs
+			continue
+		}
+		if label, ok := conflictMarkerLabel(line, ">>>>>>>"); ok && state == inTheirs {
+			block.TheirsLabel = label
+			flushPlainLines()
+			conflictFile.Segments = append(conflictFile.Segments, ConflictSegment{Block: block})
+			block = nil
+			state = outsideBlock
+			continue
+		}
+
+		switch state {
+		case inOurs:
+			block.Ours = append(block.Ours, line)
+		case inBase:
+			block.Base = append(block.Base, line)
+		case inTheirs:
+			block.Theirs = append(block.Theirs, line)
+		}
+	}
+
+	if block != nil {
+		plainLines = append(plainLines, block.RawLines...)
+	}
+	flushPlainLines()
+
+	return conflictFile
+}
+
+// the label after the conflict marker, false if the line is not the marker
+func conflictMarkerLabel(line string, marker string) (string, bool) {
+	line = strings.TrimSuffix(line, "\r")
+	if line == marker {
+		return "", true
+	}
+	if strings.HasPrefix(line, marker+" ") {
+		return line[len(marker)+1:], true
+	}
+	return "", false
+}
+
+// all the conflict blocks of the file in order
+func (cf ConflictFile) Blocks() []*ConflictBlock {
+	blocks := []*ConflictBlock{}
+	for _, segment := range cf.Segments {
+		if segment.Block != nil {
+			blocks = append(blocks, segment.Block)
+		}
+	}
+	return blocks
+}
+
+// no conflict block is left unresolved
+func (cf ConflictFile) IsResolved() bool {
+	for _, block := range cf.Blocks() {
+		if block.Resolution == CONFLICTBLOCKUNRESOLVED {
+			return false
+		}
+	}
+	return true
+}
+
+// the lines that will replace the block, an unresolved block will be kept as it is
+func (cb *ConflictBlock) ResolvedLines() []string {
+	switch cb.Resolution {
+	case CONFLICTBLOCKOURS:
+		return cb.Ours
+	case CONFLICTBLOCKTHEIRS:
+		return cb.Theirs
+	case CONFLICTBLOCKBOTH:
+		return append(append([]string{}, cb.Ours...), cb.Theirs...)
+	case CONFLICTBLOCKBASE:
+		return cb.Base
+	}
+	return cb.RawLines
+}
+
+// the content of the file after every block was replaced by its resolution
+func (cf ConflictFile) MergedContent() string {
+	mergedLines := []string{}
+	for _, segment := range cf.Segments {
+		if segment.Block != nil {
+			mergedLines = append(mergedLines, segment.Block.ResolvedLines()...)
+		} else {
+			mergedLines = append(mergedLines, segment.Lines...)
+		}
+	}
+	return strings.Join(mergedLines, "\n")
+}
+
+// ----------------------------------
+//
+//	Read the conflicted file on the working tree and parse its conflict blocks
+//
+// ----------------------------------
+func (gf *GitFiles) GetConflictFile(filePathName string) (ConflictFile, bool) {
+	content, err := os.ReadFile(filepath.Join(executor.GittiCmdExecutor.RepoPath(), filePathName))
+	if err != nil {
+		gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT CONFLICT FILE READ ERROR]: %w", err))
+		return ConflictFile{}, false
+	}
+	return ParseConflictMarkers(string(content)), true
+}
+
+// ----------------------------------
+//
+//	Write the resolution of the conflict blocks back to the file
+//	* nothing is written when no conflict block was parsed (eg, modify/delete or binary conflict, or a custom conflict-marker-size)
+//	* the file will be staged (marking the conflict as resolved) only when every parsed block was resolved
+//
+// ----------------------------------
+func (gf *GitFiles) WriteConflictResolution(filePathName string, conflictFile ConflictFile) {
+	if len(conflictFile.Blocks()) == 0 {
+		return
+	}
+	if !gf.gitProcessLock.CanProceedWithGitOps() {
+		return
+	}
+	defer gf.gitProcessLock.ReleaseGitOpsLock()
+
+	filePath := filepath.Join(executor.GittiCmdExecutor.RepoPath(), filePathName)
+	fileInfo, err := os.Stat(filePath)
+	if err != nil {
+		gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT CONFLICT RESOLUTION WRITE ERROR]: %w", err))
+		return
+	}
+	mergedContent := conflictFile.MergedContent()
+	if err := os.WriteFile(filePath, []byte(mergedContent), fileInfo.Mode().Perm()); err != nil {
+		gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT CONFLICT RESOLUTION WRITE ERROR]: %w", err))
+		return
+	}
+
+	if conflictFile.IsResolved() {
+		gitArgs := []string{"add", "--", filePathName}
+		stageCmdExecutor := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+		if err := stageCmdExecutor.Run(); err != nil {
+			gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT CONFLICT RESOLUTION STAGE ERROR]: %w", err))
+		}
+	}
+}
@@ -38,6 +38,8 @@ const (
 	DISCARDUNTRACKED          = "DISCARDUNTRACKED"
 	DISCARDNEWLYADDEDORCOPIED = "DISCARDNEWLYADDEDORCOPIED"
 	DISCARDANDREVERTRENAME    = "DISCARDANDREVERTRENAME"
+	DISCARDPARTIAL            = "DISCARDPARTIAL"   // discard only the selected hunk/line(s) of a file
+	DISCARDDIRECTORY          = "DISCARDDIRECTORY" // discard every changes under a directory
 )
 
 const (
@@ -46,12 +48,117 @@ const (
 	CONFLICTACCEPTINCOMINGCHANGES = "CONFLICTACCEPTINCOMINGCHANGES"
 )
 
+// how a conflict block within a conflicted file was resolved
+const (
+	CONFLICTBLOCKUNRESOLVED = ""       // the block will be written back with its conflict markers
+	CONFLICTBLOCKOURS       = "OURS"   // keep the local (ours) side
+	CONFLICTBLOCKTHEIRS     = "THEIRS" // keep the incoming (theirs) side
+	CONFLICTBLOCKBOTH       = "BOTH"   // keep the local side followed by the incoming side
+	CONFLICTBLOCKBASE       = "BASE"   // keep the common ancestor, only for diff3 or zdiff3 conflict style
+)
+
+// the operation that the repo is in the middle of, detected from the state files under the git dir
+const (
+	REPOOPERATIONNONE       = ""
+	REPOOPERATIONMERGE      = "MERGE"
+	REPOOPERATIONREBASE     = "REBASE"
+	REPOOPERATIONAM         = "AM" // git am shares the rebase-apply/ dir with the apply backend of rebase
+	REPOOPERATIONCHERRYPICK = "CHERRYPICK"
+	REPOOPERATIONREVERT     = "REVERT"
+	REPOOPERATIONBISECT     = "BISECT"
+)
+
+// the action to move an in progress operation forward or out
+const (
+	REPOOPERATIONCONTINUE = "CONTINUE"
+	REPOOPERATIONSKIP     = "SKIP"
+	REPOOPERATIONABORT    = "ABORT"
+)
+
+// what an ignore rule for an untracked file will cover
+const (
+	IGNORERULEPATH      = "PATH"      // the exact path of the file
+	IGNORERULEEXTENSION = "EXTENSION" // every file with the same extension
+	IGNORERULEDIRECTORY = "DIRECTORY" // the directory that the file is in
+)
+
+// the file that an ignore rule will be written to
+const (
+	IGNORETARGETROOT    = "ROOT"    // the .gitignore at the root of the repo
+	IGNORETARGETNESTED  = "NESTED"  // the nearest .gitignore above the file, or a new one next to it
+	IGNORETARGETEXCLUDE = "EXCLUDE" // .git/info/exclude, which is not shared with others
+)
+
+// how ignored files are treated by git clean
+const (
+	CLEANIGNOREDNONE    = ""        // ignored files are kept
+	CLEANIGNOREDINCLUDE = "INCLUDE" // ignored files are removed too (-x)
+	CLEANIGNOREDONLY    = "ONLY"    // only ignored files are removed (-X)
+)
+
+// where the file will be restored to from the chosen revision
+const (
+	RESTORETARGETWORKTREE = "WORKTREE" // only the working tree, the index is kept
+	RESTORETARGETSTAGED   = "STAGED"   // only the index (--staged), the working tree is kept
+	RESTORETARGETBOTH     = "BOTH"     // both the index and the working tree
+)
+
+// the kind of revision that a file can be restored from
+const (
+	RESTORESOURCEBRANCH       = "BRANCH"
+	RESTORESOURCEREMOTEBRANCH = "REMOTEBRANCH"
+	RESTORESOURCETAG          = "TAG"
+	RESTORESOURCECOMMIT       = "COMMIT"
+
+	RESTORERECENTCOMMITSCOUNT = 30 // the number of commits of the current branch to be offered as restore source
+)
+
+// the kind of ref that a commit is decorated with in the commit log
+const (
+	COMMITREFHEAD         = "HEAD"
+	COMMITREFLOCALBRANCH  = "LOCALBRANCH"
+	COMMITREFREMOTEBRANCH = "REMOTEBRANCH"
+	COMMITREFTAG          = "TAG"
+)
+
 const (
 	STREAMUPDATETHROTTLEMS = 150
 )
 
+const (
+	COMMITLOGPAGESIZE = 500 // the number of commits to be loaded at a time for the commit log
+)
+
+// the history that the commit log graph is drawn for
+const (
+	COMMITLOGSCOPECURRENTBRANCH    = "CURRENTBRANCH"    // reachable from HEAD
+	COMMITLOGSCOPEALLREFS          = "ALLREFS"          // reachable from any branch, remote branch, tag or HEAD (--all without the stash)
+	COMMITLOGSCOPESELECTEDBRANCHES = "SELECTEDBRANCHES" // reachable from the chosen local or remote branches
+	COMMITLOGSCOPEFIRSTPARENT      = "FIRSTPARENT"      // the first parent chain of HEAD (--first-parent)
+)
+
 const (
 	GETCOMBINEDDIFF = "GETCOMBINEDDIFF"
 	GETSTAGEDDIFF   = "GETSTAGEDDIFF"
 	GETUNSTAGEDDIFF = "GETUNSTAGEDDIFF"
 )
+
+// how whitespace changes are treated in diff
+const (
+	DIFFWHITESPACESHOWALL   = ""                      // whitespace changes are shown like any other changes
+	DIFFIGNORESPACECHANGE   = "--ignore-space-change" // ignore changes in amount of whitespace
+	DIFFIGNOREALLSPACE      = "--ignore-all-space"    // ignore whitespace when comparing lines
+	DIFFALGORITHMMYERS      = "myers"
+	DIFFALGORITHMPATIENCE   = "patience"
+	DIFFALGORITHMHISTOGRAM  = "histogram"
+	DEFAULTDIFFCONTEXTLINES = 3
+	MAXDIFFCONTEXTLINES     = 100
+)
+
+// how a file that can't be shown as a normal diff will be previewed
+const (
+	LARGEFILEDIFFTHRESHOLD = 1024 * 1024 // a file bigger than this (in bytes) will have its diff streamed page by page
+	LARGEFILEDIFFPAGELINES = 1000        // the number of diff lines within a page
+	MAXDIFFPAGELINELENGTH  = 4096        // a longer line (eg, minified file) of a paged diff will be truncated
+	FILEPREVIEWSNIFFLENGTH = 8000        // the number of bytes looked into to decide if a file is binary, same as git
+)
@@ -3,33 +3,54 @@ package git
 import (
 	"context"
 	"fmt"
+	"maps"
 	"os/exec"
 	"runtime"
 	"strings"
+	"sync/atomic"
 
 	"github.com/gohyuhan/gitti/executor"
+	"github.com/gohyuhan/gitti/i18n"
 )
 
 type FileStatus struct {
-	FilePathname string
-	IndexState   string
-	WorkTree     string
-	HasConflict  bool
+	FilePathname     string
+	OrigPath         string // the path before a rename or copy, empty for other changes
+	IndexState       string
+	WorkTree         string
+	HasConflict      bool
+	RenameScore      int    // similarity (in percentage) of a rename or copy
+	SubmoduleState   string // "N..." when the file is not a submodule, else "S<c><m><u>"
+	HeadFileMode     string
+	IndexFileMode    string
+	WorkTreeFileMode string
+}
+
+// the number of lines added and removed of a file, staged and unstaged changes are counted separately
+type FileLineChanges struct {
+	StagedAdded     int
+	StagedRemoved   int
+	UnstagedAdded   int
+	UnstagedRemoved int
+	IsBinary        bool // git doesn't count lines for binary files
 }
 
 type GitFiles struct {
-	filesStatus    []FileStatus
-	filesPosition  map[string]int
-	errorLog       []error
-	gitProcessLock *GitProcessLock
-	updateChannel  chan string
+	filesStatus      []FileStatus
+	filesPosition    map[string]int
+	filesLineChanges map[string]FileLineChanges
+	showIgnoredFiles atomic.Bool // include the ignored files in the files status
+	errorLog         []error
+	gitProcessLock   *GitProcessLock
+	updateChannel    chan string
 }
 
 func InitGitFile(updateChannel chan string, gitProcessLock *GitProcessLock) *GitFiles {
 	gitFiles := GitFiles{
-		filesStatus:    make([]FileStatus, 0),
-		gitProcessLock: gitProcessLock,
-		updateChannel:  updateChannel,
+		filesStatus:      make([]FileStatus, 0),
+		filesLineChanges: make(map[string]FileLineChanges),
+		gitProcessLock:   gitProcessLock,
+		updateChannel:    updateChannel,
 	}
 	return &gitFiles
 }
@@ -52,7 +73,10 @@ func (gf *GitFiles) FilesStatus() []FileStatus {
 //
 // ----------------------------------
 func (gf *GitFiles) GetGitFilesStatus() {
-	gitArgs := []string{"status", "--porcelain", "--untracked-files=all"}
+	gitArgs := []string{"status", "--porcelain=v2", "-z", "--untracked-files=all"}
+	if gf.showIgnoredFiles.Load() {
+		gitArgs = append(gitArgs, "--ignored=matching")
+	}
 
 	cmdExecutor := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
 	gitOutput, err := cmdExecutor.Output()
@@ -60,68 +84,96 @@ func (gf *GitFiles) GetGitFilesStatus() {
 		gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT FILES ERROR]: %w", err))
 	}
 
-	files := strings.Split(strings.TrimRight(string(gitOutput), "\n"), "\n")
-
-	modifiedFilesStatus := []FileStatus{}
+	modifiedFilesStatus := parsePorcelainV2FilesStatus(gitOutput)
 	modifiedFilesPositionHashmap := make(map[string]int)
-
-	for index, file := range files {
-		if len(file) < 3 {
-			continue
-		}
-
-		indexState := string(file[0])
-		worktree := string(file[1])
-		filePathName := strings.TrimSpace(file[3:])
-		hasConflict := isFilesInConflictState(indexState, worktree)
-
-		modifiedFilesStatus = append(modifiedFilesStatus, FileStatus{
-			FilePathname: filePathName,
-			IndexState:   indexState,
-			WorkTree:     worktree,
-			HasConflict:  hasConflict,
-		})
-		modifiedFilesPositionHashmap[filePathName] = index
+	for index, file := range modifiedFilesStatus {
+		modifiedFilesPositionHashmap[file.FilePathname] = index
 	}
 
+	filesLineChanges := make(map[string]FileLineChanges)
+	gf.collectFilesLineChanges(filesLineChanges, true)
+	gf.collectFilesLineChanges(filesLineChanges, false)
+
 	gf.filesPosition = modifiedFilesPositionHashmap
 	gf.filesStatus = modifiedFilesStatus
+	gf.filesLineChanges = filesLineChanges
 }
 
-// get the file diff content
-func (gf *GitFiles) GetFilesDiffInfo(ctx context.Context, fileStatus FileStatus, DiffType string) []string {
-	filePathName := fileStatus.FilePathname
-	if fileStatus.IndexState == "R" || fileStatus.IndexState == "C" {
-		if strings.Contains(filePathName, "->") {
-			parts := strings.Split(filePathName, "->")
-			if len(parts) >= 2 {
-				filePathName = strings.TrimSpace(parts[1])
-			}
-		}
+// ----------------------------------
+//
+//	Include or leave out the ignored files from the files status, it will take effect on the next retrieval
+//
+// ----------------------------------
+func (gf *GitFiles) SetShowIgnoredFiles(showIgnoredFiles bool) {
+	gf.showIgnoredFiles.Store(showIgnoredFiles)
+}
+
+func (gf *GitFiles) IsShowingIgnoredFiles() bool {
+	return gf.showIgnoredFiles.Load()
+}
+
+// ----------------------------------
+//
+//	Return the number of lines added and removed of every modified file, untracked files are not counted
+//
+// ----------------------------------
+func (gf *GitFiles) FilesLineChanges() map[string]FileLineChanges {
+	copied := make(map[string]FileLineChanges, len(gf.filesLineChanges))
+	maps.Copy(copied, gf.filesLineChanges)
+	return copied
+}
+
+// count the lines of the staged (--cached) or unstaged changes with git diff --numstat
+func (gf *GitFiles) collectFilesLineChanges(filesLineChanges map[string]FileLineChanges, isStaged bool) {
+	gitArgs := []string{"diff", "--numstat", "-z"}
+	if isStaged {
+		gitArgs = append(gitArgs, "--cached")
 	}
-	var gitArgs []string
-	switch DiffType {
-	case GETSTAGEDDIFF:
-		gitArgs = []string{"diff", "--cached", filePathName}
-	case GETUNSTAGEDDIFF:
-		gitArgs = []string{"diff", filePathName}
-	case GETCOMBINEDDIFF:
-		gitArgs = []string{"diff", "HEAD", "--", filePathName}
+	cmdExecutor := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+	gitOutput, err := cmdExecutor.Output()
+	if err != nil {
+		gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT FILES NUMSTAT ERROR]: %w", err))
+		return
 	}
-	// the file is untracked
-	isNewFile := fileStatus.WorkTree == "?" ||
-		fileStatus.IndexState == "?" ||
-		fileStatus.IndexState == "A" ||
-		(fileStatus.IndexState == "U" && fileStatus.WorkTree == "A")
 
-	if isNewFile {
-		// empty file for git diff --no-index to compares two arbitrary files outside the Git index.
-		nullFile := "/dev/null"
-		if runtime.GOOS == "windows" {
-			nullFile = "NUL"
+	for _, numstat := range parseNumstat(gitOutput) {
+		lineChanges := filesLineChanges[numstat.filePathName]
+		lineChanges.IsBinary = lineChanges.IsBinary || numstat.isBinary
+		if isStaged {
+			lineChanges.StagedAdded += numstat.added
+			lineChanges.StagedRemoved += numstat.removed
+		} else {
+			lineChanges.UnstagedAdded += numstat.added
+			lineChanges.UnstagedRemoved += numstat.removed
 		}
-		gitArgs = []string{"diff", "--no-index", nullFile, "--", filePathName}
+		filesLineChanges[numstat.filePathName] = lineChanges
+	}
+}
+
+// ----------------------------------
+//
+//	Return the status of a single file
+//
+// ----------------------------------
+func (gf *GitFiles) FileStatusOf(filePathName string) (FileStatus, bool) {
+	fileIndex, fileIndexExist := gf.filesPosition[filePathName]
+	if !fileIndexExist || fileIndex >= len(gf.filesStatus) {
+		return FileStatus{}, false
+	}
+	return gf.filesStatus[fileIndex], true
+}
+
+// the path to be shown to user, renamed or copied file will be shown as "old -> new"
+func (fs FileStatus) DisplayPathname() string {
+	if fs.OrigPath != "" {
+		return fs.OrigPath + " -> " + fs.FilePathname
 	}
+	return fs.FilePathname
+}
+
+// get the file diff content
+func (gf *GitFiles) GetFilesDiffInfo(ctx context.Context, fileStatus FileStatus, DiffType string, diffOptions DiffOptions) []string {
+	gitArgs := filesDiffGitArgs(fileStatus, DiffType, diffOptions)
 
 	cmdExecutor := executor.GittiCmdExecutor.RunGitCmdWithContext(ctx, gitArgs, true)
 	gitOutput, err := cmdExecutor.Output()
@@ -143,10 +195,49 @@ func (gf *GitFiles) GetFilesDiffInfo(ctx context.Context, fileStatus FileStatus,
 		}
 	}
 
-	fileDiffLines := processGeneralGitOpsOutputIntoStringArray(gitOutput)
+	fileDiffLines := processGitDiffOutputIntoStringArray(gitOutput)
 	return fileDiffLines
 }
 
+// the git args to get the diff of a file
+func filesDiffGitArgs(fileStatus FileStatus, DiffType string, diffOptions DiffOptions) []string {
+	filePathName := fileStatus.FilePathname
+	gitArgs := append([]string{"diff"}, diffOptions.PatchGitArgs()...)
+	switch DiffType {
+	case GETSTAGEDDIFF:
+		gitArgs = append(gitArgs, "--cached", filePathName)
+	case GETUNSTAGEDDIFF:
+		gitArgs = append(gitArgs, filePathName)
+	case GETCOMBINEDDIFF:
+		gitArgs = append(gitArgs, "HEAD", "--", filePathName)
+	}
+
+	// the staged and unstaged diff of an added file are still against the index, only its combined diff is against nothing as it is not on HEAD (there might not be a HEAD yet)
+	if isUntrackedFileStatus(fileStatus) || (DiffType == GETCOMBINEDDIFF && isNewFileStatus(fileStatus)) {
+		// empty file for git diff --no-index to compares two arbitrary files outside the Git index.
+		nullFile := "/dev/null"
+		if runtime.GOOS == "windows" {
+			nullFile = "NUL"
+		}
+		gitArgs = append([]string{"diff"}, diffOptions.PatchGitArgs()...)
+		gitArgs = append(gitArgs, "--no-index", nullFile, "--", filePathName)
+	}
+	return gitArgs
+}
+
+// the file is untracked, it is not on the index that git diff can compare against
+func isUntrackedFileStatus(fileStatus FileStatus) bool {
+	return fileStatus.WorkTree == "?" || fileStatus.IndexState == "?"
+}
+
+// the file is not on HEAD, it is untracked or newly added
+func isNewFileStatus(fileStatus FileStatus) bool {
+	return fileStatus.WorkTree == "?" ||
+		fileStatus.IndexState == "?" ||
+		fileStatus.IndexState == "A" ||
+		(fileStatus.IndexState == "U" && fileStatus.WorkTree == "A")
+}
+
 func (gf *GitFiles) StageOrUnstageFile(filePathName string) {
 	if !gf.gitProcessLock.CanProceedWithGitOps() {
 		return
@@ -156,15 +247,12 @@ func (gf *GitFiles) StageOrUnstageFile(filePathName string) {
 	fileIndex, fileIndexExist := gf.filesPosition[filePathName]
 	if fileIndexExist {
 		file := gf.filesStatus[fileIndex]
-		// "old -> new" format for both Renamed (R) and Copied (C)
-		// This covers IndexState R/C and the rare WorkTree R/C
-		if strings.Contains(filePathName, "->") &&
-			(file.IndexState == "R" || file.IndexState == "C" || file.WorkTree == "R" || file.WorkTree == "C") {
-			filePathName = strings.TrimSpace(strings.Split(filePathName, "->")[1])
-		}
 
 		var gitArgs []string
-		if file.IndexState == "?" && file.WorkTree == "?" {
+		if file.IndexState == "!" {
+			// ignored, only shown for inspection
+			return
+		} else if file.IndexState == "?" && file.WorkTree == "?" {
 			// not tracked
 			gitArgs = []string{"add", "--", filePathName}
 			stageCmdExecutor := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
@@ -191,6 +279,81 @@ func (gf *GitFiles) StageOrUnstageFile(filePathName string) {
 	}
 }
 
+// ----------------------------------
+//
+//	Stage or unstage every file under a directory
+//	  - stage when any file under the directory still has unstaged changes, else unstage all of them
+//
+// ----------------------------------
+func (gf *GitFiles) StageOrUnstageDirectory(directoryPath string) {
+	if !gf.gitProcessLock.CanProceedWithGitOps() {
+		return
+	}
+	defer gf.gitProcessLock.ReleaseGitOpsLock()
+
+	filesUnderDirectory := gf.filesStatusUnderDirectory(directoryPath)
+	if len(filesUnderDirectory) < 1 {
+		return
+	}
+
+	hasUnstagedChanges := false
+	for _, file := range filesUnderDirectory {
+		// staging a directory would also mark the conflicts within it as resolved, so we don't allow it
+		if file.HasConflict {
+			return
+		}
+		if file.WorkTree != " " {
+			hasUnstagedChanges = true
+		}
+	}
+
+	// the directory is passed as a literal pathspec, so a directory name with glob characters will not match other paths
+	var gitArgs []string
+	if hasUnstagedChanges {
+		gitArgs = []string{"--literal-pathspecs", "add", "--all", "--", directoryPath}
+	} else {
+		gitArgs = append([]string{"--literal-pathspecs", "reset", "--"}, directoryPathspecs(directoryPath, filesUnderDirectory)...)
+	}
+	cmdExecutor := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+	cmdExecutor.Run()
+}
+
+// ----------------------------------
+//
+//	Stage or unstage several files at once
+//	  - stage when any of the files still has unstaged changes, else unstage all of them
+//
+// ----------------------------------
+func (gf *GitFiles) StageOrUnstageFiles(filePathNames []string) {
+	if !gf.gitProcessLock.CanProceedWithGitOps() {
+		return
+	}
+	defer gf.gitProcessLock.ReleaseGitOpsLock()
+
+	files := gf.filesStatusOf(filePathNames)
+	if len(files) < 1 {
+		return
+	}
+
+	hasUnstagedChanges := false
+	for _, file := range files {
+		if file.WorkTree != " " {
+			hasUnstagedChanges = true
+			break
+		}
+	}
+
+	// the paths are passed as literal pathspecs, so a marked path with glob characters will not match the unmarked files
+	var gitArgs []string
+	if hasUnstagedChanges {
+		gitArgs = append([]string{"--literal-pathspecs", "add", "--all", "--"}, filesPathspecs(files)...)
+	} else {
+		gitArgs = append([]string{"--literal-pathspecs", "reset", "--"}, filesPathspecs(files)...)
+	}
+	cmdExecutor := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+	cmdExecutor.Run()
+}
+
 func (gf *GitFiles) StageAllChanges() {
 	if !gf.gitProcessLock.CanProceedWithGitOps() {
 		return
@@ -235,15 +398,6 @@ func (gf *GitFiles) DiscardFileChanges(filePathName string, discardType string)
 			return
 		}
 		filePathName = file.FilePathname
-		// Store the full name (e.g. "old -> new") for the rename logic later
-		fullFilePathName := file.FilePathname
-
-		// "old -> new" format for both Renamed (R) and Copied (C)
-		// This covers IndexState R/C and the rare WorkTree R/C
-		if strings.Contains(filePathName, "->") &&
-			(file.IndexState == "R" || file.IndexState == "C" || file.WorkTree == "R" || file.WorkTree == "C") {
-			filePathName = strings.TrimSpace(strings.Split(filePathName, "->")[1])
-		}
 
 		switch discardType {
 		case DISCARDWHOLE:
@@ -264,8 +418,8 @@ func (gf *GitFiles) DiscardFileChanges(filePathName string, discardType string)
 			gitArgs = []string{"rm", "-f", filePathName}
 		case DISCARDANDREVERTRENAME:
 			needToRunExecutor = false
-			oldFilePathName := strings.TrimSpace(strings.Split(fullFilePathName, "->")[0])
-			newFilePathName := strings.TrimSpace(strings.Split(fullFilePathName, "->")[1])
+			oldFilePathName := file.OrigPath
+			newFilePathName := file.FilePathname
 
 			// retrieve back the original file
 			gitArgs = []string{"reset", "--", oldFilePathName}
@@ -302,6 +456,154 @@ func (gf *GitFiles) DiscardFileChanges(filePathName string, discardType string)
 	}
 }
 
+// ----------------------------------
+//
+//	Discard all changes (staged, unstaged and untracked) under a directory
+//
+// ----------------------------------
+func (gf *GitFiles) DiscardDirectoryChanges(directoryPath string) {
+	if !gf.gitProcessLock.CanProceedWithGitOps() {
+		return
+	}
+	defer gf.gitProcessLock.ReleaseGitOpsLock()
+
+	filesUnderDirectory := gf.filesStatusUnderDirectory(directoryPath)
+	if len(filesUnderDirectory) < 1 {
+		return
+	}
+	for _, file := range filesUnderDirectory {
+		if file.HasConflict {
+			return
+		}
+	}
+
+	gf.discardAllChanges(directoryPathspecs(directoryPath, filesUnderDirectory), []string{directoryPath})
+}
+
+// ----------------------------------
+//
+//	Discard all changes (staged, unstaged and untracked) of several files at once
+//	  - files in conflict state are left untouched
+//
+// ----------------------------------
+func (gf *GitFiles) DiscardFilesChanges(filePathNames []string) ([]string, bool) {
+	if !gf.gitProcessLock.CanProceedWithGitOps() {
+		return []string{gf.gitProcessLock.OtherProcessRunningWarning()}, false
+	}
+	defer gf.gitProcessLock.ReleaseGitOpsLock()
+
+	files := []FileStatus{}
+	skippedFiles := []string{}
+	for _, file := range gf.filesStatusOf(filePathNames) {
+		if file.HasConflict {
+			skippedFiles = append(skippedFiles, fmt.Sprintf(i18n.LANGUAGEMAPPING.GitDiscardSkippedConflictFile, file.FilePathname))
+			continue
+		}
+		files = append(files, file)
+	}
+	if len(files) < 1 {
+		return skippedFiles, false
+	}
+
+	cleanPaths := make([]string, 0, len(files))
+	for _, file := range files {
+		cleanPaths = append(cleanPaths, file.FilePathname)
+	}
+	gitOpsOutput, success := gf.discardAllChanges(filesPathspecs(files), cleanPaths)
+	return append(gitOpsOutput, skippedFiles...), success && len(skippedFiles) < 1
+}
+
+// unstage everything first so that newly added files become untracked and can be cleaned,
+// then revert the tracked files back to HEAD and remove the untracked ones, along with the untracked directories under a discarded directory
+// the paths are passed as literal pathspecs, a file named with glob characters (eg, *.log) must not remove the other files it would match
+func (gf *GitFiles) discardAllChanges(pathspecs []string, cleanPaths []string) ([]string, bool) {
+	gitOpsOutput := []string{}
+	success := true
+
+	gitArgs := append([]string{"--literal-pathspecs", "reset", "--quiet", "--"}, pathspecs...)
+	resetCmdExecutor := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+	resetOutput, resetErr := resetCmdExecutor.CombinedOutput()
+	if resetErr != nil {
+		gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT DISCARD ERROR]: %w", resetErr))
+		gitOpsOutput = append(gitOpsOutput, processGeneralGitOpsOutputIntoStringArray(resetOutput)...)
+		success = false
+	}
+
+	// checkout one by one, a pathspec that match no tracked file will fail the whole checkout
+	// (eg, a file that was newly added and is untracked now), so the error is ignored here
+	for _, pathspec := range pathspecs {
+		gitArgs = []string{"--literal-pathspecs", "checkout", "--", pathspec}
+		checkoutCmdExecutor := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+		checkoutCmdExecutor.Run()
+	}
+
+	gitArgs = append([]string{"--literal-pathspecs", "clean", "-f", "-d", "--"}, cleanPaths...)
+	cleanCmdExecutor := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+	cleanOutput, cleanErr := cleanCmdExecutor.CombinedOutput()
+	gitOpsOutput = append(gitOpsOutput, processGeneralGitOpsOutputIntoStringArray(cleanOutput)...)
+	if cleanErr != nil {
+		gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT DISCARD ERROR]: %w", cleanErr))
+		success = false
+	}
+
+	// the clean doesn't trigger any write in .git folder, so we trigger a fetch here to prevent a "lag" in the UI
+	go func() {
+		gf.GetGitFilesStatus()
+		gf.updateChannel <- GIT_FILES_STATUS_UPDATE
+	}()
+	return gitOpsOutput, success
+}
+
+// return the status of the given files, files that no longer have any changes are left out
+func (gf *GitFiles) filesStatusOf(filePathNames []string) []FileStatus {
+	files := []FileStatus{}
+	for _, filePathName := range filePathNames {
+		fileIndex, fileIndexExist := gf.filesPosition[filePathName]
+		if fileIndexExist && fileIndex < len(gf.filesStatus) {
+			files = append(files, gf.filesStatus[fileIndex])
+		}
+	}
+	return files
+}
+
+// the path of the files plus the original path of the renamed ones, else only half of the rename will be handled
+func filesPathspecs(files []FileStatus) []string {
+	pathspecs := make([]string, 0, len(files))
+	for _, file := range files {
+		pathspecs = append(pathspecs, file.FilePathname)
+		if file.IndexState == "R" && file.OrigPath != "" {
+			pathspecs = append(pathspecs, file.OrigPath)
+		}
+	}
+	return pathspecs
+}
+
+// return the status of every file under the directory
+func (gf *GitFiles) filesStatusUnderDirectory(directoryPath string) []FileStatus {
+	directoryPrefix := strings.TrimSuffix(directoryPath, "/") + "/"
+	filesUnderDirectory := []FileStatus{}
+	for _, file := range gf.filesStatus {
+		// ignored files are only shown for inspection, they are left out of the directory operations
+		if file.IndexState != "!" && strings.HasPrefix(file.FilePathname, directoryPrefix) {
+			filesUnderDirectory = append(filesUnderDirectory, file)
+		}
+	}
+	return filesUnderDirectory
+}
+
+// the directory itself plus the original path of the files that were renamed into it,
+// else only half of the rename will be reverted
+func directoryPathspecs(directoryPath string, filesUnderDirectory []FileStatus) []string {
+	directoryPrefix := strings.TrimSuffix(directoryPath, "/") + "/"
+	pathspecs := []string{directoryPath}
+	for _, file := range filesUnderDirectory {
+		if file.IndexState == "R" && file.OrigPath != "" && !strings.HasPrefix(file.OrigPath, directoryPrefix) {
+			pathspecs = append(pathspecs, file.OrigPath)
+		}
+	}
+	return pathspecs
+}
+
 func (gf *GitFiles) GitResolveConflict(filePathName string, resolveType string) {
 	if !gf.gitProcessLock.CanProceedWithGitOps() {
 		return
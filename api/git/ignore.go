@@ -0,0 +1,234 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/gohyuhan/gitti/executor"
+)
+
+// a rule that will ignore an untracked file, together with the file it will be written to
+type IgnoreRule struct {
+	RuleType   string
+	TargetType string
+	TargetPath string // path of the ignore file relative to the repo root
+	Rule       string // the line to be appended to the ignore file
+}
+
+// the ignore rule that an ignored file matched, as reported by git check-ignore -v
+type IgnoreMatch struct {
+	Source     string // the file the rule came from, eg, .gitignore or the core.excludesFile
+	LineNumber string
+	Pattern    string
+}
+
+// ----------------------------------
+//
+//	Return the types of rule that can be used to ignore the file
+//	* extension is left out for a file without one, or a dotfile like .env (which is the whole file name)
+//	* directory is left out for a file at the root of the repo
+//
+// ----------------------------------
+func IgnoreRuleTypesFor(filePathName string) []string {
+	ruleTypes := []string{IGNORERULEPATH}
+	fileName := path.Base(filePathName)
+	extension := path.Ext(fileName)
+	if extension != "" && extension != fileName {
+		ruleTypes = append(ruleTypes, IGNORERULEEXTENSION)
+	}
+	if path.Dir(filePathName) != "." {
+		ruleTypes = append(ruleTypes, IGNORERULEDIRECTORY)
+	}
+	return ruleTypes
+}
+
+// ----------------------------------
+//
+//	Return the rule of the given type for every file it can be written to, so the rule can be previewed before writing
+//	* the rule is relative to the directory of the ignore file, the nested one is left out
+//	  when it can't express the rule (eg, the directory rule for a .gitignore within that directory)
+//
+// ----------------------------------
+func IgnoreRulesFor(filePathName string, ruleType string) []IgnoreRule {
+	nestedDirectory := nearestNestedGitignoreDirectory(filePathName)
+	targets := []struct {
+		targetType string
+		targetPath string
+		baseDir    string
+	}{
+		{IGNORETARGETROOT, ".gitignore", "."},
+		{IGNORETARGETNESTED, path.Join(nestedDirectory, ".gitignore"), nestedDirectory},
+		{IGNORETARGETEXCLUDE, path.Join(".git", "info", "exclude"), "."},
+	}
+
+	rules := []IgnoreRule{}
+	for _, target := range targets {
+		if target.targetType == IGNORETARGETNESTED && target.baseDir == "." {
+			// same as the root .gitignore
+			continue
+		}
+		rule, ok := ignoreRuleRelativeTo(filePathName, ruleType, target.baseDir)
+		if !ok {
+			continue
+		}
+		rules = append(rules, IgnoreRule{
+			RuleType:   ruleType,
+			TargetType: target.targetType,
+			TargetPath: target.targetPath,
+			Rule:       rule,
+		})
+	}
+	return rules
+}
+
+// the directory of the nearest .gitignore above the file (excluding the root one), else the directory of the file itself
+func nearestNestedGitignoreDirectory(filePathName string) string {
+	fileDirectory := path.Dir(filePathName)
+	for directory := fileDirectory; directory != "."; directory = path.Dir(directory) {
+		if _, err := os.Stat(filepath.Join(executor.GittiCmdExecutor.RepoPath(), filepath.FromSlash(directory), ".gitignore")); err == nil {
+			return directory
+		}
+	}
+	return fileDirectory
+}
+
+func ignoreRuleRelativeTo(filePathName string, ruleType string, baseDir string) (string, bool) {
+	relativePath := filePathName
+	if baseDir != "." {
+		relativePath = strings.TrimPrefix(filePathName, baseDir+"/")
+	}
+
+	switch ruleType {
+	case IGNORERULEPATH:
+		return "/" + escapeIgnorePattern(relativePath), true
+	case IGNORERULEEXTENSION:
+		return "*" + escapeIgnorePattern(path.Ext(filePathName)), true
+	case IGNORERULEDIRECTORY:
+		relativeDirectory := path.Dir(relativePath)
+		if relativeDirectory == "." {
+			return "", false
+		}
+		return "/" + escapeIgnorePattern(relativeDirectory) + "/", true
+	}
+	return "", false
+}
+
+// escape the characters that carry a meaning in an ignore file, so that the path is matched literally
+func escapeIgnorePattern(pattern string) string {
+	trailingSpacesIndex := len(strings.TrimRight(pattern, " "))
+	var escaped strings.Builder
+	for index, char := range pattern {
+		switch {
+		case char == '*', char == '?', char == '[', char == '\\':
+			escaped.WriteRune('\\')
+		case (char == '#' || char == '!') && index == 0:
+			escaped.WriteRune('\\')
+		case char == ' ' && index >= trailingSpacesIndex:
+			// trailing spaces are dropped unless escaped
+			escaped.WriteRune('\\')
+		}
+		escaped.WriteRune(char)
+	}
+	return escaped.String()
+}
+
+// ----------------------------------
+//
+//	Append the rule to its ignore file, the file is created when it doesn't exist yet
+//	* the rule will not be written again if the ignore file already has it
+//
+// ----------------------------------
+func (gf *GitFiles) WriteIgnoreRule(ignoreRule IgnoreRule) {
+	if !gf.gitProcessLock.CanProceedWithGitOps() {
+		return
+	}
+	defer gf.gitProcessLock.ReleaseGitOpsLock()
+
+	ignoreFilePath := filepath.Join(executor.GittiCmdExecutor.RepoPath(), filepath.FromSlash(ignoreRule.TargetPath))
+	if ignoreRule.TargetType == IGNORETARGETEXCLUDE {
+		// the git dir is not always .git at the root (eg, a worktree or submodule)
+		gitArgs := []string{"rev-parse", "--git-path", "info/exclude"}
+		cmdExecutor := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+		gitOutput, err := cmdExecutor.Output()
+		if err != nil {
+			gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT IGNORE ERROR]: %w", err))
+			return
+		}
+		ignoreFilePath = strings.TrimSpace(string(gitOutput))
+		if !filepath.IsAbs(ignoreFilePath) {
+			ignoreFilePath = filepath.Join(executor.GittiCmdExecutor.RepoPath(), ignoreFilePath)
+		}
+	}
+
+	content, err := os.ReadFile(ignoreFilePath)
+	if err != nil && !os.IsNotExist(err) {
+		gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT IGNORE ERROR]: %w", err))
+		return
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		if strings.TrimRight(line, "\r") == ignoreRule.Rule {
+			return
+		}
+	}
+
+	appendContent := ignoreRule.Rule + "\n"
+	if len(content) > 0 && !strings.HasSuffix(string(content), "\n") {
+		appendContent = "\n" + appendContent
+	}
+	if err := os.MkdirAll(filepath.Dir(ignoreFilePath), 0o755); err != nil {
+		gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT IGNORE ERROR]: %w", err))
+		return
+	}
+	ignoreFile, err := os.OpenFile(ignoreFilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT IGNORE ERROR]: %w", err))
+		return
+	}
+	defer ignoreFile.Close()
+	if _, err := ignoreFile.WriteString(appendContent); err != nil {
+		gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT IGNORE ERROR]: %w", err))
+		return
+	}
+
+	// a .gitignore in the working tree doesn't trigger any write in .git folder, so we trigger a fetch here to refresh the status
+	go func() {
+		gf.GetGitFilesStatus()
+		gf.updateChannel <- GIT_FILES_STATUS_UPDATE
+	}()
+}
+
+// ----------------------------------
+//
+//	Return the rule that the ignored file matched, false when the file is not ignored
+//
+// ----------------------------------
+func (gf *GitFiles) GitCheckIgnore(ctx context.Context, filePathName string) (IgnoreMatch, bool) {
+	// -z only works with the path read from stdin
+	gitArgs := []string{"check-ignore", "-v", "-z", "--stdin"}
+	cmdExecutor := executor.GittiCmdExecutor.RunGitCmdWithContext(ctx, gitArgs, false)
+	cmdExecutor.Stdin = strings.NewReader(filePathName + "\x00")
+	gitOutput, err := cmdExecutor.Output()
+	if err != nil {
+		// exit code 1 means the file is not ignored
+		if exitErr, ok := err.(*exec.ExitError); !ok || exitErr.ExitCode() != 1 {
+			gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT CHECK IGNORE ERROR]: %w", err))
+		}
+		return IgnoreMatch{}, false
+	}
+
+	// <source>\0<line number>\0<pattern>\0<path>\0
+	fields := strings.Split(string(gitOutput), "\x00")
+	if len(fields) < 4 || fields[0] == "" {
+		return IgnoreMatch{}, false
+	}
+	return IgnoreMatch{
+		Source:     fields[0],
+		LineNumber: fields[1],
+		Pattern:    fields[2],
+	}, true
+}
@@ -9,6 +9,8 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/gohyuhan/gitti/executor"
 )
@@ -17,17 +19,37 @@ import (
 type CommitLog struct {
 	Hash         string
 	Parents      []string
+	Refs         []CommitRef // the HEAD, branches and tags that point at the commit
 	Message      string
 	Author       string
 	LaneCharInfo []Cell
 	ColorID      int
 }
 
+// a ref that a commit is decorated with, the name is the short name (eg, main, origin/main or v1.0.0)
+type CommitRef struct {
+	Name    string
+	RefType string
+}
+
 type GitCommitLog struct {
-	errorLog           []error
-	gitCommitLogOutput []CommitLog
-	updateChannel      chan string
-	gitProcessLock     *GitProcessLock
+	errorLog             []error
+	gitCommitLogOutput   []CommitLog
+	gitCommitLogOutputMu sync.RWMutex
+	graphRenderer        *GraphRenderer  // the lane state after the last loaded commit, so that the graph of the next page continue from it
+	pagingRevisions      []string        // the tips that the pages are loaded from, the commits added on top of them later are not counted as paged
+	pagedCount           int             // the number of commits loaded from the paging revisions
+	loadedFilter         CommitLogFilter // the filter that the loaded commits were limited by
+	loadedScope          CommitLogScope  // the scope that the loaded commits were drawn for
+	commitLogScope       CommitLogScope
+	commitLogScopeMu     sync.RWMutex
+	commitLogFilter      CommitLogFilter
+	commitLogFilterMu    sync.RWMutex
+	hasMoreCommitLogs    atomic.Bool
+	isLoadingNextPage    atomic.Bool
+	commitLogLoadMu      sync.Mutex // a refresh and the loading of the next page can't happen at the same time
+	updateChannel        chan string
+	gitProcessLock       *GitProcessLock
 }
 
 // ----------------------------------
@@ -38,6 +60,8 @@ type GitCommitLog struct {
 func InitGitCommitLog(updateChannel chan string, gitProcessLock *GitProcessLock) *GitCommitLog {
 	gitCommitLog := GitCommitLog{
 		gitCommitLogOutput: make([]CommitLog, 0),
+		graphRenderer:      NewGraphRenderer(),
+		commitLogScope:     CommitLogScope{Mode: COMMITLOGSCOPECURRENTBRANCH},
 		gitProcessLock:     gitProcessLock,
 		updateChannel:      updateChannel,
 	}
@@ -50,69 +74,361 @@ func InitGitCommitLog(updateChannel chan string, gitProcessLock *GitProcessLock)
 //
 // ----------------------------------
 func (gCL *GitCommitLog) GitCommitLogOutput() []CommitLog {
+	gCL.gitCommitLogOutputMu.RLock()
+	defer gCL.gitCommitLogOutputMu.RUnlock()
+
 	copied := make([]CommitLog, len(gCL.gitCommitLogOutput))
 	copy(copied, gCL.gitCommitLogOutput)
 	return copied
 }
 
+// ----------------------------------
+//
+//	Return true if there are older commits that were not loaded yet
+//
+// ----------------------------------
+func (gCL *GitCommitLog) HasMoreCommitLogs() bool {
+	return gCL.hasMoreCommitLogs.Load()
+}
+
 // ----------------------------------
 //
 //	Get the Commit log
+//	* only the decorations will be refreshed when the tips of the scope are still where the commits were loaded from
+//	* only the commits added on top of the loaded ones will be rendered and prepended,
+//	  all the loaded commits will be loaded again when the history was rewritten (eg, amend, rebase, reset or switching branch)
+//	* when the commit log is filtered, the graph is not rendered as the parents of a commit might not be within the filtered commits,
+//	  and all the loaded commits will be loaded again when the tips moved
+//	* the same goes for the scopes with more than one tip, as the new commits can be on top of any of them
+//	* the commit log starts over from the first page only when the filter or the scope was changed
 //
 // ----------------------------------
 func (gCL *GitCommitLog) GetCommitLogs() {
-	// 1. Prepare git command
+	gCL.commitLogLoadMu.Lock()
+	defer gCL.commitLogLoadMu.Unlock()
+
+	gCL.gitCommitLogOutputMu.RLock()
+	previousTopHash := ""
+	if len(gCL.gitCommitLogOutput) > 0 {
+		previousTopHash = gCL.gitCommitLogOutput[0].Hash
+	}
+	loadedCount := len(gCL.gitCommitLogOutput)
+	gCL.gitCommitLogOutputMu.RUnlock()
+
+	filter := gCL.CommitLogFilter()
+	scope := gCL.CommitLogScope()
+	tips := resolveCommitLogScopeTips(scope)
+	isSameLog := filter == gCL.loadedFilter && scope.isSame(gCL.loadedScope)
+	if isSameLog && len(tips) > 0 && slices.Equal(tips, gCL.pagingRevisions) {
+		// the refs might have moved among the loaded commits without any new commit (eg, push, fetch or a new tag)
+		gCL.refreshCommitLogRefs(nil)
+		return
+	}
+
+	isSingleTipScope := scope.Mode == COMMITLOGSCOPECURRENTBRANCH || scope.Mode == COMMITLOGSCOPEFIRSTPARENT
+	if previousTopHash != "" && isSameLog && filter.IsEmpty() && isSingleTipScope {
+		newCommitLogs, ok := gCL.commitLogsOnTopOf(previousTopHash, scope)
+		if ok {
+			gCL.refreshCommitLogRefs(newCommitLogs)
+			return
+		}
+	}
+
+	// the pages are loaded from the commits that the tips of the scope are at now,
+	// as many commits as were loaded are loaded again so the position within the commit log is kept
+	count := COMMITLOGPAGESIZE
+	if isSameLog {
+		count = max(count, loadedCount)
+	}
+	renderer := NewGraphRenderer()
+	gitCommitLogOutput := []CommitLog{}
+	hasMore := false
+	if len(tips) > 0 {
+		gitCommitLogOutput, hasMore = gCL.fetchCommitLogsPage(tips, 0, count, renderer, filter, scope)
+	}
+
+	gCL.gitCommitLogOutputMu.Lock()
+	gCL.gitCommitLogOutput = gitCommitLogOutput
+	gCL.gitCommitLogOutputMu.Unlock()
+	gCL.graphRenderer = renderer
+	gCL.pagingRevisions = tips
+	gCL.pagedCount = len(gitCommitLogOutput)
+	gCL.loadedFilter = filter
+	gCL.loadedScope = scope
+	gCL.hasMoreCommitLogs.Store(hasMore)
+}
+
+// prepend the new commits to the loaded ones and decorate all of them with where the refs are at now
+func (gCL *GitCommitLog) refreshCommitLogRefs(newCommitLogs []CommitLog) {
+	refsByHash := commitRefsByHash()
+	gCL.gitCommitLogOutputMu.Lock()
+	defer gCL.gitCommitLogOutputMu.Unlock()
+	gCL.gitCommitLogOutput = append(newCommitLogs, gCL.gitCommitLogOutput...)
+	for index := range gCL.gitCommitLogOutput {
+		gCL.gitCommitLogOutput[index].Refs = refsByHash[gCL.gitCommitLogOutput[index].Hash]
+	}
+}
+
+// ----------------------------------
+//
+//	Load the next page of older commits, the graph will continue from the lane state of the last loaded commit
+//	* return false when there is nothing more to load or a page is already being loaded
+//
+// ----------------------------------
+func (gCL *GitCommitLog) LoadNextCommitLogPage() bool {
+	if !gCL.hasMoreCommitLogs.Load() || !gCL.isLoadingNextPage.CompareAndSwap(false, true) {
+		return false
+	}
+	defer gCL.isLoadingNextPage.Store(false)
+
+	gCL.commitLogLoadMu.Lock()
+	defer gCL.commitLogLoadMu.Unlock()
+	return gCL.loadNextCommitLogPage()
+}
+
+// ----------------------------------
+//
+//	Load the next pages until the commit is loaded
+//	* the position of the commit is looked up first, so the pages up to it are loaded at once and nothing is loaded when it is not there
+//	* return false when the commit is not within the commit log that was loaded for the filter and scope
+//
+// ----------------------------------
+func (gCL *GitCommitLog) LoadCommitLogPagesUntil(commitHash string) bool {
+	gCL.commitLogLoadMu.Lock()
+	defer gCL.commitLogLoadMu.Unlock()
+
+	if gCL.isCommitLogLoaded(commitHash) {
+		return true
+	}
+	if !gCL.hasMoreCommitLogs.Load() || len(gCL.pagingRevisions) < 1 {
+		return false
+	}
+	position, ok := gCL.commitLogPosition(commitHash)
+	if !ok || position < gCL.pagedCount {
+		return false
+	}
+
+	// round up to whole pages, so the later pages are still loaded from the same boundaries
+	count := (position - gCL.pagedCount + COMMITLOGPAGESIZE) / COMMITLOGPAGESIZE * COMMITLOGPAGESIZE
+	nextPages, hasMore := gCL.fetchCommitLogsPage(gCL.pagingRevisions, gCL.pagedCount, count, gCL.graphRenderer, gCL.loadedFilter, gCL.loadedScope)
+
+	gCL.gitCommitLogOutputMu.Lock()
+	gCL.gitCommitLogOutput = append(gCL.gitCommitLogOutput, nextPages...)
+	gCL.gitCommitLogOutputMu.Unlock()
+	gCL.pagedCount += len(nextPages)
+	gCL.hasMoreCommitLogs.Store(hasMore)
+	return gCL.isCommitLogLoaded(commitHash)
+}
+
+func (gCL *GitCommitLog) isCommitLogLoaded(commitHash string) bool {
+	gCL.gitCommitLogOutputMu.RLock()
+	defer gCL.gitCommitLogOutputMu.RUnlock()
+	return slices.ContainsFunc(gCL.gitCommitLogOutput, func(cL CommitLog) bool { return cL.Hash == commitHash })
+}
+
+// the position of the commit among the commits reachable from the paging revisions, in the same order as they are paged
+// only the hashes are listed, and the listing stops once the commit was reached
+func (gCL *GitCommitLog) commitLogPosition(commitHash string) (int, bool) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	gitArgs := []string{"log", "--topo-order", "--format=%H"}
+	if gCL.loadedScope.Mode == COMMITLOGSCOPEFIRSTPARENT {
+		gitArgs = append(gitArgs, "--first-parent")
+	}
+	filterArgs, pathArgs := gCL.loadedFilter.gitArgs()
+	gitArgs = append(gitArgs, filterArgs...)
+	gitArgs = append(gitArgs, "--stdin")
+	gitArgs = append(gitArgs, pathArgs...)
+
+	cmd := executor.GittiCmdExecutor.RunGitCmdWithContext(ctx, gitArgs, false)
+	cmd.Stdin = strings.NewReader(strings.Join(gCL.pagingRevisions, "\n") + "\n")
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		gCL.errorLog = append(gCL.errorLog, fmt.Errorf("[GIT LOG ERROR]: %s", err.Error()))
+		return 0, false
+	}
+	if err := cmd.Start(); err != nil {
+		gCL.errorLog = append(gCL.errorLog, fmt.Errorf("[GIT LOG ERROR]: %s", err.Error()))
+		return 0, false
+	}
+	defer func() {
+		cancel()
+		cmd.Wait()
+	}()
+
+	scanner := bufio.NewScanner(stdout)
+	position := 0
+	for scanner.Scan() {
+		if scanner.Text() == commitHash {
+			return position, true
+		}
+		position++
+	}
+	return 0, false
+}
+
+// the commit log load lock must be held by the caller
+func (gCL *GitCommitLog) loadNextCommitLogPage() bool {
+	if len(gCL.pagingRevisions) < 1 {
+		return false
+	}
+	nextPage, hasMore := gCL.fetchCommitLogsPage(gCL.pagingRevisions, gCL.pagedCount, COMMITLOGPAGESIZE, gCL.graphRenderer, gCL.loadedFilter, gCL.loadedScope)
+
+	gCL.gitCommitLogOutputMu.Lock()
+	gCL.gitCommitLogOutput = append(gCL.gitCommitLogOutput, nextPage...)
+	gCL.gitCommitLogOutputMu.Unlock()
+	gCL.pagedCount += len(nextPage)
+	gCL.hasMoreCommitLogs.Store(hasMore)
+	return len(nextPage) > 0
+}
+
+// ----------------------------------
+//
+//	Fetch count commits starting from the skip-th commit reachable from the revisions, each rendered with the renderer
+//	* one more commit than the count is asked for to know if there are more, it is not rendered
+//	* the commits are not rendered when the filter limits the commit log
+//	* the revisions are given through stdin as there can be a lot of them (eg, every tag for the all refs scope)
+//
+// ----------------------------------
+func (gCL *GitCommitLog) fetchCommitLogsPage(revisions []string, skip int, count int, renderer *GraphRenderer, filter CommitLogFilter, scope CommitLogScope) ([]CommitLog, bool) {
 	gitArgs := []string{
 		"log",
 		"--topo-order",
-		"--pretty=format:%H|%P|%s|%an",
-		"-n", "2500",
+		"--decorate=full",
+		"--pretty=format:%H%x00%P%x00%D%x00%s%x00%an",
+		fmt.Sprintf("--skip=%d", skip),
+		"-n", strconv.Itoa(count + 1),
+	}
+	if scope.Mode == COMMITLOGSCOPEFIRSTPARENT {
+		gitArgs = append(gitArgs, "--first-parent")
 	}
+	filterArgs, pathArgs := filter.gitArgs()
+	gitArgs = append(gitArgs, filterArgs...)
+	gitArgs = append(gitArgs, "--stdin")
+	gitArgs = append(gitArgs, pathArgs...)
 
 	cmd := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+	cmd.Stdin = strings.NewReader(strings.Join(revisions, "\n") + "\n")
 	// Use pipe to process line-by-line to avoid loading entire history into memory
 	stdout, err := cmd.StdoutPipe()
 	if err != nil {
 		gCL.errorLog = append(gCL.errorLog, fmt.Errorf("[GIT LOG ERROR]: %s", err.Error()))
-		return
+		return []CommitLog{}, false
 	}
 
 	if err := cmd.Start(); err != nil {
 		gCL.errorLog = append(gCL.errorLog, fmt.Errorf("[GIT LOG ERROR]: %s", err.Error()))
-		return
+		return []CommitLog{}, false
 	}
 
 	scanner := bufio.NewScanner(stdout)
-	renderer := NewGraphRenderer()
 	gitCommitLogOutput := make([]CommitLog, 0)
-	// 2. Process Commits
+	hasMore := false
 	for scanner.Scan() {
-		line := scanner.Text()
-		parts := strings.SplitN(line, "|", 4)
-		if len(parts) < 4 {
+		cL, ok := parseCommitLogLine(scanner.Text())
+		if !ok {
 			continue
 		}
-
-		// Parse commit
-		cL := CommitLog{
-			Hash:    parts[0],
-			Message: parts[2],
-			Author:  parts[3],
-		}
-		if len(parts[1]) > 0 {
-			cL.Parents = strings.Split(parts[1], " ")
+		if len(gitCommitLogOutput) >= count {
+			hasMore = true
+			break
 		}
 
-		// 3. Render
 		// The renderer returns the commit lane string
-		laneCharInfo, colorID := renderer.RenderCommit(cL)
-
-		cL.LaneCharInfo = laneCharInfo
-		cL.ColorID = colorID
+		if filter.IsEmpty() {
+			cL.LaneCharInfo, cL.ColorID = renderer.RenderCommit(commitLogForGraph(cL, scope))
+		}
 		gitCommitLogOutput = append(gitCommitLogOutput, cL)
 	}
+	// an unborn branch has no commit, git log will fail on it
+	cmd.Wait()
 
-	gCL.gitCommitLogOutput = gitCommitLogOutput
+	return gitCommitLogOutput, hasMore
+}
+
+// ----------------------------------
+//
+//	Return the commits added on top of the previous top commit, rendered as if the whole log was rendered again
+//	* return false when the previous top commit is no longer the start of the graph below the new commits,
+//	  (eg, it is not in the history anymore or a merge brought in older commits that will be shown below it)
+//
+// ----------------------------------
+func (gCL *GitCommitLog) commitLogsOnTopOf(previousTopHash string, scope CommitLogScope) ([]CommitLog, bool) {
+	headHashCmd := executor.GittiCmdExecutor.RunGitCmd([]string{"rev-parse", "HEAD"}, false)
+	headHashOutput, err := headHashCmd.Output()
+	if err != nil {
+		return nil, false
+	}
+	if strings.TrimSpace(string(headHashOutput)) == previousTopHash {
+		return []CommitLog{}, true
+	}
+	isAncestorCmd := executor.GittiCmdExecutor.RunGitCmd([]string{"merge-base", "--is-ancestor", previousTopHash, "HEAD"}, false)
+	if isAncestorCmd.Run() != nil {
+		return nil, false
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	gitArgs := []string{"log", "--topo-order", "--decorate=full", "--pretty=format:%H%x00%P%x00%D%x00%s%x00%an"}
+	if scope.Mode == COMMITLOGSCOPEFIRSTPARENT {
+		gitArgs = append(gitArgs, "--first-parent")
+	}
+	gitArgs = append(gitArgs, "HEAD")
+	cmd := executor.GittiCmdExecutor.RunGitCmdWithContext(ctx, gitArgs, false)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, false
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, false
+	}
+	defer func() {
+		// stop reading the rest of the history once the previous top commit was reached
+		cancel()
+		cmd.Wait()
+	}()
+
+	scanner := bufio.NewScanner(stdout)
+	renderer := NewGraphRenderer()
+	newCommitLogs := make([]CommitLog, 0)
+	for scanner.Scan() {
+		cL, ok := parseCommitLogLine(scanner.Text())
+		if !ok {
+			continue
+		}
+		if cL.Hash == previousTopHash {
+			// the previous top commit was rendered with no lane before it,
+			// the lanes of the new commits need to end up as the only lane leading to it for the graph below to stay the same
+			lanes := renderer.currentLanes
+			isSameGraph := len(lanes) == 1 && lanes[0].Hash == previousTopHash && lanes[0].ColorID == 0
+			return newCommitLogs, isSameGraph
+		}
+		if len(newCommitLogs) >= COMMITLOGPAGESIZE {
+			return nil, false
+		}
+		cL.LaneCharInfo, cL.ColorID = renderer.RenderCommit(commitLogForGraph(cL, scope))
+		newCommitLogs = append(newCommitLogs, cL)
+	}
+	return nil, false
+}
+
+// parse a line of --pretty=format:%H%x00%P%x00%D%x00%s%x00%an with --decorate=full
+func parseCommitLogLine(line string) (CommitLog, bool) {
+	parts := strings.SplitN(line, "\x00", 5)
+	if len(parts) < 5 {
+		return CommitLog{}, false
+	}
+
+	cL := CommitLog{
+		Hash:    parts[0],
+		Refs:    parseCommitRefs(parts[2]),
+		Message: parts[3],
+		Author:  parts[4],
+	}
+	if len(parts[1]) > 0 {
+		cL.Parents = strings.Split(parts[1], " ")
+	}
+	return cL, true
 }
 
 // RenderCommit generates the visual graph line for a single commit.
@@ -174,11 +490,13 @@ func (g *GraphRenderer) RenderCommit(cL CommitLog) ([]Cell, int) {
 
 	// Case: New Tip (Root or independent branch start)
 	// If the commit isn't in our tracked lanes, it's a new starting point.
+	// With more than one tip (eg, --all), this can happen while other lanes are still open,
+	// so the new lane takes a ColorID that none of the open lanes is using.
 	if commitLaneIdx == -1 {
 		// Create a new Lane identity.
 		commitLane = Lane{
 			Hash:    cL.Hash,
-			ColorID: len(g.currentLanes),
+			ColorID: unusedLaneColorID(g.currentLanes),
 		}
 
 		// Append to the rightmost side (Visual preference).
@@ -210,6 +528,10 @@ func (g *GraphRenderer) RenderCommit(cL CommitLog) ([]Cell, int) {
 	// Map: ParentIndex (0, 1..) -> Destination Column Index in nextLanes
 	forkDestinations := make(map[int]int)
 
+	// Track where the pass-through lanes go to.
+	// Map: Current Lane Index -> Destination Column Index in nextLanes
+	passThroughDestinations := make(map[int]int)
+
 	parents := cL.Parents
 
 	// Iterate through CURRENT lanes to decide their fate.
@@ -248,6 +570,7 @@ func (g *GraphRenderer) RenderCommit(cL CommitLog) ([]Cell, int) {
 			} else {
 				// Independent lane (Pass-Through).
 				// It just carries over to the next state, keeping its ColorID.
+				passThroughDestinations[i] = len(nextLanes)
 				nextLanes = append(nextLanes, l)
 			}
 		}
@@ -262,7 +585,7 @@ func (g *GraphRenderer) RenderCommit(cL CommitLog) ([]Cell, int) {
 			// Start a NEW Lane with a NEW ColorID
 			newLane := Lane{
 				Hash:    pHash,
-				ColorID: len(nextLanes),
+				ColorID: unusedLaneColorID(nextLanes),
 			}
 
 			// Append to the list
@@ -322,7 +645,6 @@ func (g *GraphRenderer) RenderCommit(cL CommitLog) ([]Cell, int) {
 
 	// Drawing Layer 1: Vertical Pipes (Pass-Throughs)
 	// These are lanes that are NOT the current commit and NOT merging in.
-	checkedNextLanesIndices := make(map[int]bool)
 	for i := range g.currentLanes {
 		if i == commitLaneIdx {
 			continue
@@ -343,13 +665,10 @@ func (g *GraphRenderer) RenderCommit(cL CommitLog) ([]Cell, int) {
 		lane := g.currentLanes[i]
 
 		// Find where this lane goes in nextLanes
-		nextIdx := -1
-		for j, nl := range nextLanes {
-			if nl.ColorID == lane.ColorID && !checkedNextLanesIndices[j] {
-				checkedNextLanesIndices[j] = true
-				nextIdx = j
-				break
-			}
+		// (tracked by position, as lanes of different tips can share the same ColorID)
+		nextIdx, ok := passThroughDestinations[i]
+		if !ok {
+			nextIdx = -1
 		}
 
 		if nextIdx == -1 {
@@ -414,13 +733,16 @@ func (g *GraphRenderer) RenderCommit(cL CommitLog) ([]Cell, int) {
 	return cells, commitLaneIdx
 }
 
-func (gCL *GitCommitLog) GitCommitLogDetail(ctx context.Context, commitHash string) []string {
+func (gCL *GitCommitLog) GitCommitLogDetail(ctx context.Context, commitHash string, diffOptions DiffOptions) []string {
 	var gitArgs []string
 
+	// a large commit is only shown as a stat, the diff of each file can be viewed from the changed files of the commit
 	if gCL.checkIsLargeCommit(commitHash) {
-		gitArgs = []string{"show", commitHash}
+		gitArgs = append([]string{"show"}, diffOptions.GitArgs()...)
+		gitArgs = append(gitArgs, "--stat", commitHash)
 	} else {
-		gitArgs = []string{"show", "--stat", commitHash}
+		gitArgs = append([]string{"show"}, diffOptions.PatchGitArgs()...)
+		gitArgs = append(gitArgs, commitHash)
 	}
 
 	cmdExecutor := executor.GittiCmdExecutor.RunGitCmdWithContext(ctx, gitArgs, true)
@@ -479,3 +801,75 @@ func (gCL *GitCommitLog) checkIsLargeCommit(commitHash string) bool {
 
 	return filesChanged > fileThreshold
 }
+
+// the smallest ColorID that none of the lanes is using
+func unusedLaneColorID(lanes []Lane) int {
+	colorID := 0
+	for slices.ContainsFunc(lanes, func(l Lane) bool { return l.ColorID == colorID }) {
+		colorID++
+	}
+	return colorID
+}
+
+// ----------------------------------
+//
+//	Parse the %D decoration with --decorate=full into typed refs
+//	* the full ref names tell a local branch apart from a remote branch with a slash in its name
+//	* <remote>/HEAD and the refs other than branch and tag (eg, refs/stash) are left out
+//
+// ----------------------------------
+func parseCommitRefs(decoration string) []CommitRef {
+	if strings.TrimSpace(decoration) == "" {
+		return nil
+	}
+
+	refs := []CommitRef{}
+	for _, decorationPart := range strings.Split(decoration, ", ") {
+		decorationPart = strings.TrimSpace(decorationPart)
+		if headTarget, ok := strings.CutPrefix(decorationPart, "HEAD -> "); ok {
+			refs = append(refs, CommitRef{Name: "HEAD", RefType: COMMITREFHEAD})
+			decorationPart = headTarget
+		}
+
+		switch {
+		case decorationPart == "HEAD":
+			// detached HEAD
+			refs = append(refs, CommitRef{Name: "HEAD", RefType: COMMITREFHEAD})
+		case strings.HasPrefix(decorationPart, "tag: refs/tags/"):
+			refs = append(refs, CommitRef{Name: strings.TrimPrefix(decorationPart, "tag: refs/tags/"), RefType: COMMITREFTAG})
+		case strings.HasPrefix(decorationPart, "refs/heads/"):
+			refs = append(refs, CommitRef{Name: strings.TrimPrefix(decorationPart, "refs/heads/"), RefType: COMMITREFLOCALBRANCH})
+		case strings.HasPrefix(decorationPart, "refs/remotes/"):
+			name := strings.TrimPrefix(decorationPart, "refs/remotes/")
+			if !strings.HasSuffix(name, "/HEAD") {
+				refs = append(refs, CommitRef{Name: name, RefType: COMMITREFREMOTEBRANCH})
+			}
+		}
+	}
+	return refs
+}
+
+// ----------------------------------
+//
+//	Return the refs of every commit that is pointed at by HEAD, a branch or a tag
+//
+// ----------------------------------
+func commitRefsByHash() map[string][]CommitRef {
+	refsByHash := make(map[string][]CommitRef)
+	gitArgs := []string{"log", "--no-walk=unsorted", "--decorate=full", "--pretty=format:%H%x00%D", "--branches", "--remotes", "--tags", "HEAD"}
+	cmd := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+	output, err := cmd.Output()
+	if err != nil {
+		return refsByHash
+	}
+	for line := range strings.SplitSeq(string(output), "\n") {
+		hash, decoration, ok := strings.Cut(line, "\x00")
+		if !ok {
+			continue
+		}
+		if refs := parseCommitRefs(decoration); len(refs) > 0 {
+			refsByHash[hash] = refs
+		}
+	}
+	return refsByHash
+}
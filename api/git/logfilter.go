@@ -0,0 +1,106 @@
+package git
+
+import (
+	"strings"
+)
+
+// the git log options that the commit log is limited by, an empty field will not limit the commit log
+type CommitLogFilter struct {
+	Message      string // --grep
+	Author       string // --author
+	Since        string // --since, anything that git understand as a date (eg, 2024-01-31 or 2 weeks ago)
+	Until        string // --until
+	Path         string // the path limiter after --
+	PickaxeText  string // -S, commits that changed the number of occurrences of the text
+	PickaxeRegex string // -G, commits with added or removed lines matching the regex
+}
+
+// ----------------------------------
+//
+//	Return true if the filter doesn't limit the commit log
+//
+// ----------------------------------
+func (f CommitLogFilter) IsEmpty() bool {
+	return f.Message == "" && f.Author == "" && f.Since == "" && f.Until == "" && f.Path == "" && f.PickaxeText == "" && f.PickaxeRegex == ""
+}
+
+// ----------------------------------
+//
+//	Return the git log options of the filter, the path limiter is returned separately as it has to come after the revision
+//	* message and author are matched case insensitively
+//
+// ----------------------------------
+func (f CommitLogFilter) gitArgs() ([]string, []string) {
+	gitArgs := []string{}
+	if f.Message != "" || f.Author != "" {
+		gitArgs = append(gitArgs, "--regexp-ignore-case")
+	}
+	if f.Message != "" {
+		gitArgs = append(gitArgs, "--grep="+f.Message)
+	}
+	if f.Author != "" {
+		gitArgs = append(gitArgs, "--author="+f.Author)
+	}
+	if f.Since != "" {
+		gitArgs = append(gitArgs, "--since="+f.Since)
+	}
+	if f.Until != "" {
+		gitArgs = append(gitArgs, "--until="+f.Until)
+	}
+	if f.PickaxeText != "" {
+		gitArgs = append(gitArgs, "-S"+f.PickaxeText)
+	}
+	if f.PickaxeRegex != "" {
+		gitArgs = append(gitArgs, "-G"+f.PickaxeRegex)
+	}
+
+	pathArgs := []string{}
+	if f.Path != "" {
+		pathArgs = append(pathArgs, "--", f.Path)
+	}
+	return gitArgs, pathArgs
+}
+
+// ----------------------------------
+//
+//	Return the filter as the git log options it maps to, for showing the active filter
+//
+// ----------------------------------
+func (f CommitLogFilter) String() string {
+	gitArgs, pathArgs := f.gitArgs()
+	parts := []string{}
+	for _, arg := range gitArgs {
+		if arg != "--regexp-ignore-case" {
+			parts = append(parts, arg)
+		}
+	}
+	parts = append(parts, pathArgs...)
+	return strings.Join(parts, " ")
+}
+
+// ----------------------------------
+//
+//	Return the filter that the commit log is currently limited by
+//
+// ----------------------------------
+func (gCL *GitCommitLog) CommitLogFilter() CommitLogFilter {
+	gCL.commitLogFilterMu.RLock()
+	defer gCL.commitLogFilterMu.RUnlock()
+	return gCL.commitLogFilter
+}
+
+// ----------------------------------
+//
+//	Limit the commit log by the filter, it takes effect on the next GetCommitLogs
+//	* -S and -G can't be used together, so the filter will not be set when both were given
+//
+// ----------------------------------
+func (gCL *GitCommitLog) SetCommitLogFilter(filter CommitLogFilter) bool {
+	if filter.PickaxeText != "" && filter.PickaxeRegex != "" {
+		return false
+	}
+	gCL.commitLogFilterMu.Lock()
+	defer gCL.commitLogFilterMu.Unlock()
+	gCL.commitLogFilter = filter
+	return true
+}
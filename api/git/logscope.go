@@ -0,0 +1,136 @@
+package git
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gohyuhan/gitti/executor"
+)
+
+// the history that the commit log is drawn for, branches are only used by COMMITLOGSCOPESELECTEDBRANCHES
+type CommitLogScope struct {
+	Mode     string
+	Branches []CommitRef
+}
+
+// ----------------------------------
+//
+//	Return the scope that the commit log is currently drawn for
+//
+// ----------------------------------
+func (gCL *GitCommitLog) CommitLogScope() CommitLogScope {
+	gCL.commitLogScopeMu.RLock()
+	defer gCL.commitLogScopeMu.RUnlock()
+	return gCL.commitLogScope
+}
+
+// ----------------------------------
+//
+//	Draw the commit log for the scope, it takes effect on the next GetCommitLogs
+//	* return false when no branch was chosen for the selected branches scope
+//
+// ----------------------------------
+func (gCL *GitCommitLog) SetCommitLogScope(scope CommitLogScope) bool {
+	if scope.Mode == COMMITLOGSCOPESELECTEDBRANCHES && len(scope.Branches) < 1 {
+		return false
+	}
+	gCL.commitLogScopeMu.Lock()
+	defer gCL.commitLogScopeMu.Unlock()
+	gCL.commitLogScope = scope
+	return true
+}
+
+// ----------------------------------
+//
+//	Return the local and remote branches that can be chosen for the selected branches scope
+//
+// ----------------------------------
+func (gCL *GitCommitLog) CommitLogScopeBranchCandidates() []CommitRef {
+	gitArgs := []string{"for-each-ref", "--format=%(refname)", "refs/heads", "refs/remotes"}
+	cmd := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+	output, err := cmd.Output()
+	if err != nil {
+		gCL.errorLog = append(gCL.errorLog, fmt.Errorf("[GIT LOG SCOPE ERROR]: %w", err))
+		return []CommitRef{}
+	}
+
+	branches := []CommitRef{}
+	for refName := range strings.SplitSeq(strings.TrimSpace(string(output)), "\n") {
+		if name, ok := strings.CutPrefix(refName, "refs/heads/"); ok {
+			branches = append(branches, CommitRef{Name: name, RefType: COMMITREFLOCALBRANCH})
+		} else if name, ok := strings.CutPrefix(refName, "refs/remotes/"); ok && !strings.HasSuffix(name, "/HEAD") {
+			branches = append(branches, CommitRef{Name: name, RefType: COMMITREFREMOTEBRANCH})
+		}
+	}
+	return branches
+}
+
+// the scopes are the same when they draw the same history
+func (scope CommitLogScope) isSame(other CommitLogScope) bool {
+	return scope.Mode == other.Mode && slices.Equal(scope.Branches, other.Branches)
+}
+
+// the full ref name of a branch
+func (ref CommitRef) FullName() string {
+	if ref.RefType == COMMITREFREMOTEBRANCH {
+		return "refs/remotes/" + ref.Name
+	}
+	return "refs/heads/" + ref.Name
+}
+
+// ----------------------------------
+//
+//	Resolve the tips of the scope into commit hashes, so that the later pages are loaded from the same tips even after they moved
+//	* the chosen branches that no longer exist are left out
+//
+// ----------------------------------
+func resolveCommitLogScopeTips(scope CommitLogScope) []string {
+	var cmdOutput []byte
+	var err error
+	switch scope.Mode {
+	case COMMITLOGSCOPEALLREFS:
+		cmd := executor.GittiCmdExecutor.RunGitCmd([]string{"rev-parse", "--exclude=refs/stash", "--all"}, false)
+		cmdOutput, err = cmd.Output()
+	case COMMITLOGSCOPESELECTEDBRANCHES:
+		gitArgs := []string{"for-each-ref", "--format=%(objectname) %(refname)"}
+		for _, branch := range scope.Branches {
+			gitArgs = append(gitArgs, branch.FullName())
+		}
+		cmd := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+		cmdOutput, err = cmd.Output()
+		if err == nil {
+			// for-each-ref also match the refs under a pattern (eg, refs/heads/feature match refs/heads/feature/a)
+			hashes := []string{}
+			for line := range strings.SplitSeq(strings.TrimSpace(string(cmdOutput)), "\n") {
+				hash, refName, ok := strings.Cut(line, " ")
+				if ok && slices.ContainsFunc(scope.Branches, func(branch CommitRef) bool { return branch.FullName() == refName }) {
+					hashes = append(hashes, hash)
+				}
+			}
+			cmdOutput = []byte(strings.Join(hashes, "\n"))
+		}
+	default:
+		cmd := executor.GittiCmdExecutor.RunGitCmd([]string{"rev-parse", "--verify", "--quiet", "HEAD"}, false)
+		cmdOutput, err = cmd.Output()
+	}
+	if err != nil {
+		return []string{}
+	}
+
+	tips := []string{}
+	for hash := range strings.SplitSeq(strings.TrimSpace(string(cmdOutput)), "\n") {
+		if hash != "" && !slices.Contains(tips, hash) {
+			tips = append(tips, hash)
+		}
+	}
+	return tips
+}
+
+// only the first parent is followed for the first parent scope, the other parents of a merge will never show up in the commit log
+func commitLogForGraph(cL CommitLog, scope CommitLogScope) CommitLog {
+	if scope.Mode == COMMITLOGSCOPEFIRSTPARENT && len(cL.Parents) > 1 {
+		cL.Parents = cL.Parents[:1]
+	}
+	return cL
+}
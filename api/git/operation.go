@@ -0,0 +1,280 @@
+package git
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gohyuhan/gitti/executor"
+)
+
+// the operation that the repo is in the middle of, with the progress for rebase and am
+type RepoOperationState struct {
+	Operation   string
+	CurrentStep int // 0 when the operation has no progress to report
+	TotalSteps  int
+}
+
+type GitRepoOperation struct {
+	errorLog                 []error
+	absoluteGitRepoPath      string
+	repoOperationState       RepoOperationState
+	repoOperationStateMu     sync.RWMutex
+	gitRepoOperationOutput   []string
+	gitRepoOperationOutputMu sync.RWMutex
+	gitProcessLock           *GitProcessLock
+	updateChannel            chan string
+}
+
+func InitGitRepoOperation(absoluteGitRepoPath string, updateChannel chan string, gitProcessLock *GitProcessLock) *GitRepoOperation {
+	gitRepoOperation := &GitRepoOperation{
+		errorLog:               []error{},
+		absoluteGitRepoPath:    absoluteGitRepoPath,
+		repoOperationState:     RepoOperationState{},
+		gitRepoOperationOutput: []string{},
+		gitProcessLock:         gitProcessLock,
+		updateChannel:          updateChannel,
+	}
+
+	return gitRepoOperation
+}
+
+// ----------------------------------
+//
+//	Return the operation that the repo is in the middle of
+//
+// ----------------------------------
+func (gro *GitRepoOperation) RepoOperationState() RepoOperationState {
+	gro.repoOperationStateMu.RLock()
+	defer gro.repoOperationStateMu.RUnlock()
+	return gro.repoOperationState
+}
+
+// ----------------------------------
+//
+//	Detect the operation that the repo is in the middle of from the state files under the git dir
+//	* rebase is checked first as a rebase that stopped on a conflict will also leave behind the head of the picked commit
+//	* bisect is checked last as the other operations can be carried out while bisecting
+//
+// ----------------------------------
+func (gro *GitRepoOperation) GetLatestRepoOperationState() {
+	state := RepoOperationState{Operation: REPOOPERATIONNONE}
+	switch {
+	case gro.gitDirPathExist("rebase-merge"):
+		state.Operation = REPOOPERATIONREBASE
+		state.CurrentStep = gro.readGitDirNumber(filepath.Join("rebase-merge", "msgnum"))
+		state.TotalSteps = gro.readGitDirNumber(filepath.Join("rebase-merge", "end"))
+	case gro.gitDirPathExist("rebase-apply"):
+		state.Operation = REPOOPERATIONREBASE
+		if gro.gitDirPathExist(filepath.Join("rebase-apply", "applying")) {
+			state.Operation = REPOOPERATIONAM
+		}
+		state.CurrentStep = gro.readGitDirNumber(filepath.Join("rebase-apply", "next"))
+		state.TotalSteps = gro.readGitDirNumber(filepath.Join("rebase-apply", "last"))
+	case gro.gitDirPathExist("MERGE_HEAD"):
+		state.Operation = REPOOPERATIONMERGE
+	case gro.gitDirPathExist("CHERRY_PICK_HEAD"):
+		state.Operation = REPOOPERATIONCHERRYPICK
+	case gro.gitDirPathExist("REVERT_HEAD"):
+		state.Operation = REPOOPERATIONREVERT
+	case gro.gitDirPathExist("BISECT_LOG"):
+		state.Operation = REPOOPERATIONBISECT
+	}
+
+	gro.repoOperationStateMu.Lock()
+	defer gro.repoOperationStateMu.Unlock()
+	gro.repoOperationState = state
+}
+
+func (gro *GitRepoOperation) gitDirPathExist(name string) bool {
+	_, err := os.Stat(filepath.Join(gro.absoluteGitRepoPath, name))
+	return err == nil
+}
+
+// read a state file that only hold a number, 0 if it can't be read
+func (gro *GitRepoOperation) readGitDirNumber(name string) int {
+	content, err := os.ReadFile(filepath.Join(gro.absoluteGitRepoPath, name))
+	if err != nil {
+		return 0
+	}
+	number, err := strconv.Atoi(strings.TrimSpace(string(content)))
+	if err != nil {
+		return 0
+	}
+	return number
+}
+
+// ----------------------------------
+//
+//	Return the actions that can be carried out for the operation
+//	* a merge can't be skipped, bisect has nothing to continue (skip will skip the current commit and abort will reset the bisect)
+//
+// ----------------------------------
+func RepoOperationActions(operation string) []string {
+	switch operation {
+	case REPOOPERATIONMERGE:
+		return []string{REPOOPERATIONCONTINUE, REPOOPERATIONABORT}
+	case REPOOPERATIONREBASE, REPOOPERATIONAM, REPOOPERATIONCHERRYPICK, REPOOPERATIONREVERT:
+		return []string{REPOOPERATIONCONTINUE, REPOOPERATIONSKIP, REPOOPERATIONABORT}
+	case REPOOPERATIONBISECT:
+		return []string{REPOOPERATIONSKIP, REPOOPERATIONABORT}
+	}
+	return []string{}
+}
+
+// the git args to carry out the action for the operation, nil if the action is not supported by the operation
+func RepoOperationActionGitArgs(operation string, action string) []string {
+	var command string
+	switch operation {
+	case REPOOPERATIONMERGE:
+		command = "merge"
+	case REPOOPERATIONREBASE:
+		command = "rebase"
+	case REPOOPERATIONAM:
+		command = "am"
+	case REPOOPERATIONCHERRYPICK:
+		command = "cherry-pick"
+	case REPOOPERATIONREVERT:
+		command = "revert"
+	case REPOOPERATIONBISECT:
+		switch action {
+		case REPOOPERATIONSKIP:
+			return []string{"bisect", "skip"}
+		case REPOOPERATIONABORT:
+			return []string{"bisect", "reset"}
+		}
+		return nil
+	default:
+		return nil
+	}
+
+	switch action {
+	case REPOOPERATIONCONTINUE:
+		return []string{command, "--continue"}
+	case REPOOPERATIONSKIP:
+		if operation == REPOOPERATIONMERGE {
+			return nil
+		}
+		return []string{command, "--skip"}
+	case REPOOPERATIONABORT:
+		return []string{command, "--abort"}
+	}
+	return nil
+}
+
+// --------------------------------
+//
+// return the output of the operation action
+//
+// --------------------------------
+func (gro *GitRepoOperation) GetGitRepoOperationOutput() []string {
+	gro.gitRepoOperationOutputMu.RLock()
+	defer gro.gitRepoOperationOutputMu.RUnlock()
+
+	copied := make([]string, len(gro.gitRepoOperationOutput))
+	copy(copied, gro.gitRepoOperationOutput)
+	return copied
+}
+
+// --------------------------------
+//
+//	Continue, skip or abort the in progress operation with the output streamed
+//	* the editor is replaced with a no-op so that the prepared commit message is used as it is
+//
+// --------------------------------
+func (gro *GitRepoOperation) GitRepoOperationAction(ctx context.Context, operation string, action string) int {
+	if !gro.gitProcessLock.CanProceedWithGitOps() {
+		return -1
+	}
+	defer func() {
+		gro.gitProcessLock.ReleaseGitOpsLock()
+	}()
+
+	gro.ClearGitRepoOperationOutput()
+	gitArgs := RepoOperationActionGitArgs(operation, action)
+	if gitArgs == nil {
+		gro.errorLog = append(gro.errorLog, fmt.Errorf("[GIT REPO OPERATION ERROR]: %s can't be carried out for %s", action, operation))
+		return -1
+	}
+
+	cmdExecutor := executor.GittiCmdExecutor.RunGitCmdWithContext(ctx, gitArgs, true)
+	cmdExecutor.Env = append(os.Environ(), "GIT_EDITOR=true")
+
+	// Combine stderr into stdout
+	stdout, err := cmdExecutor.StdoutPipe()
+	if err != nil {
+		gro.errorLog = append(gro.errorLog, fmt.Errorf("[PIPE ERROR]: %w", err))
+		return -1
+	}
+	cmdExecutor.Stderr = cmdExecutor.Stdout
+
+	// Start the process
+	if err := cmdExecutor.Start(); err != nil {
+		gro.errorLog = append(gro.errorLog, fmt.Errorf("[START ERROR]: %w", err))
+		return -1
+	}
+
+	// Stream combined output
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		scanner := bufio.NewScanner(stdout)
+		scanner.Split(splitOnCarriageReturnOrNewline)
+		cursorIndex := 0
+		lastSent := time.Time{}
+		for scanner.Scan() {
+			select {
+			case <-ctx.Done():
+				return // Stop immediately on cancel
+			default:
+				gro.gitRepoOperationOutputMu.Lock()
+				updatedCursorIndex, updatedGitRepoOperationOutput := handleProgressOutputStream(cursorIndex, scanner, gro.gitRepoOperationOutput)
+				gro.gitRepoOperationOutput = updatedGitRepoOperationOutput
+				cursorIndex = updatedCursorIndex
+				gro.gitRepoOperationOutputMu.Unlock()
+				if time.Since(lastSent) >= STREAMUPDATETHROTTLEMS*time.Millisecond {
+					select {
+					case gro.updateChannel <- GIT_REPO_OPERATION_OUTPUT_UPDATE:
+						lastSent = time.Now()
+					default:
+					}
+				}
+			}
+		}
+		// trigger an update once it ends
+		gro.updateChannel <- GIT_REPO_OPERATION_OUTPUT_UPDATE
+	}()
+
+	waitErr := cmdExecutor.Wait()
+	wg.Wait()
+
+	if waitErr != nil {
+		if exitErr, ok := waitErr.(*exec.ExitError); ok {
+			status := exitErr.ExitCode()
+			gro.errorLog = append(gro.errorLog, fmt.Errorf("[GIT REPO OPERATION ERROR]: %w", waitErr))
+			return status
+		}
+		gro.errorLog = append(gro.errorLog, fmt.Errorf("[UNEXPECTED ERROR]: %w", waitErr))
+		return -1
+	}
+	return 0
+}
+
+// --------------------------------
+//
+// # Clear the Git Process Output
+//
+// --------------------------------
+func (gro *GitRepoOperation) ClearGitRepoOperationOutput() {
+	gro.gitRepoOperationOutputMu.Lock()
+	defer gro.gitRepoOperationOutputMu.Unlock()
+	gro.gitRepoOperationOutput = []string{}
+}
@@ -0,0 +1,499 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gohyuhan/gitti/executor"
+)
+
+var ansiEscapeSequenceRegex = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+var hunkHeaderRegex = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@`)
+
+type DiffLine struct {
+	Kind     string // "+", "-", " " or "\" (no newline at end of file marker)
+	Content  string // the line content without the leading kind character
+	RawIndex int    // position of this line within the diff lines it was parsed from
+}
+
+type DiffHunk struct {
+	Header         string
+	OldStart       int
+	OldCount       int
+	NewStart       int
+	NewCount       int
+	Lines          []DiffLine
+	HeaderRawIndex int // position of the hunk header within the diff lines it was parsed from
+}
+
+type FileDiff struct {
+	FileHeader []string // the lines before the first hunk (diff --git, index, ---, +++ ...)
+	Hunks      []DiffHunk
+}
+
+// to point to a single line within a hunk of a FileDiff
+type DiffLinePosition struct {
+	HunkIndex int
+	LineIndex int
+}
+
+// the options that change how a diff is generated, it apply to file, commit and stash diff
+type DiffOptions struct {
+	Whitespace    string // DIFFWHITESPACESHOWALL, DIFFIGNORESPACECHANGE or DIFFIGNOREALLSPACE
+	ContextLines  int
+	Algorithm     string // DIFFALGORITHMMYERS, DIFFALGORITHMPATIENCE or DIFFALGORITHMHISTOGRAM
+	DetectRenames bool
+}
+
+// a single row of a side by side diff, the old and new line of the same row are meant to be shown next to each other
+type SideBySideDiffRow struct {
+	OldLine       string // the raw line for the old side (empty when there is nothing to show on the old side)
+	NewLine       string // the raw line for the new side (empty when there is nothing to show on the new side)
+	OldLineNumber int    // 0 when the row is not a line of the old content
+	NewLineNumber int    // 0 when the row is not a line of the new content
+}
+
+func DefaultDiffOptions() DiffOptions {
+	return DiffOptions{
+		Whitespace:    DIFFWHITESPACESHOWALL,
+		ContextLines:  DEFAULTDIFFCONTEXTLINES,
+		Algorithm:     DIFFALGORITHMMYERS,
+		DetectRenames: true,
+	}
+}
+
+// the git arguments for the options, to be placed right after the git subcommand
+// * the context lines is not included as `-U<n>` imply `--patch`, use PatchGitArgs when the patch is wanted
+func (do DiffOptions) GitArgs() []string {
+	gitArgs := []string{"--diff-algorithm=" + do.Algorithm}
+	if do.Whitespace != DIFFWHITESPACESHOWALL {
+		gitArgs = append(gitArgs, do.Whitespace)
+	}
+	if do.DetectRenames {
+		gitArgs = append(gitArgs, "--find-renames")
+	} else {
+		gitArgs = append(gitArgs, "--no-renames")
+	}
+	return gitArgs
+}
+
+func (do DiffOptions) PatchGitArgs() []string {
+	return append(do.GitArgs(), fmt.Sprintf("-U%d", do.ContextLines))
+}
+
+// a partial patch (hunk/line) can only be built from a diff that show exactly how the file was changed with enough context to be applied
+func (do DiffOptions) IsPatchable() bool {
+	return do.Whitespace == DIFFWHITESPACESHOWALL && do.ContextLines > 0
+}
+
+// ----------------------------------
+//
+//	Parse the output of GetFilesDiffInfo into hunks and lines
+//
+// ----------------------------------
+func ParseFileDiff(diffLines []string) FileDiff {
+	fileDiff := FileDiff{
+		FileHeader: []string{},
+		Hunks:      []DiffHunk{},
+	}
+
+	for index, rawLine := range diffLines {
+		line := ansiEscapeSequenceRegex.ReplaceAllString(rawLine, "")
+
+		if match := hunkHeaderRegex.FindStringSubmatch(line); match != nil {
+			fileDiff.Hunks = append(fileDiff.Hunks, DiffHunk{
+				Header:         line,
+				OldStart:       atoiOrDefault(match[1], 0),
+				OldCount:       atoiOrDefault(match[2], 1),
+				NewStart:       atoiOrDefault(match[3], 0),
+				NewCount:       atoiOrDefault(match[4], 1),
+				Lines:          []DiffLine{},
+				HeaderRawIndex: index,
+			})
+			continue
+		}
+
+		if len(fileDiff.Hunks) == 0 {
+			fileDiff.FileHeader = append(fileDiff.FileHeader, line)
+			continue
+		}
+
+		// an empty line within a hunk is a context line with empty content
+		// (the trailing whitespace of the diff output might have been trimmed)
+		kind := " "
+		content := ""
+		if len(line) > 0 {
+			kind = line[:1]
+			content = line[1:]
+		}
+		if kind != "+" && kind != "-" && kind != " " && kind != "\\" {
+			continue
+		}
+
+		currentHunk := &fileDiff.Hunks[len(fileDiff.Hunks)-1]
+		currentHunk.Lines = append(currentHunk.Lines, DiffLine{
+			Kind:     kind,
+			Content:  content,
+			RawIndex: index,
+		})
+	}
+
+	return fileDiff
+}
+
+// ----------------------------------
+//
+//	Split the diff lines into rows of old and new lines for side by side view
+//	* it work for any output that contain diff (git diff, git show, git stash show -p),
+//	  the lines that are not part of a hunk will be shown on both side
+//	* return false if there is no hunk within the lines
+//
+// ----------------------------------
+func SplitDiffLinesSideBySide(diffLines []string) ([]SideBySideDiffRow, bool) {
+	rows := []SideBySideDiffRow{}
+	hasHunk := false
+	inHunk := false
+	oldLineNumber, newLineNumber := 0, 0
+	removedRows := []SideBySideDiffRow{}
+	addedRows := []SideBySideDiffRow{}
+
+	// pair up the removed and added lines of a change block so that they are shown on the same rows
+	flushChangeBlock := func() {
+		for index := range max(len(removedRows), len(addedRows)) {
+			row := SideBySideDiffRow{}
+			if index < len(removedRows) {
+				row.OldLine = removedRows[index].OldLine
+				row.OldLineNumber = removedRows[index].OldLineNumber
+			}
+			if index < len(addedRows) {
+				row.NewLine = addedRows[index].NewLine
+				row.NewLineNumber = addedRows[index].NewLineNumber
+			}
+			rows = append(rows, row)
+		}
+		removedRows = removedRows[:0]
+		addedRows = addedRows[:0]
+	}
+
+	for _, rawLine := range diffLines {
+		line := ansiEscapeSequenceRegex.ReplaceAllString(rawLine, "")
+
+		if match := hunkHeaderRegex.FindStringSubmatch(line); match != nil {
+			flushChangeBlock()
+			hasHunk = true
+			inHunk = true
+			oldLineNumber = atoiOrDefault(match[1], 0)
+			newLineNumber = atoiOrDefault(match[3], 0)
+			rows = append(rows, SideBySideDiffRow{OldLine: rawLine, NewLine: rawLine})
+			continue
+		}
+
+		kind := ""
+		if len(line) > 0 {
+			kind = line[:1]
+		}
+		// a line that doesn't belong to a hunk (eg, the start of the next file diff, commit message) end the current hunk
+		if inHunk && kind != "+" && kind != "-" && kind != " " && kind != "\\" && line != "" {
+			inHunk = false
+		}
+		if !inHunk {
+			flushChangeBlock()
+			rows = append(rows, SideBySideDiffRow{OldLine: rawLine, NewLine: rawLine})
+			continue
+		}
+
+		switch kind {
+		case "-":
+			removedRows = append(removedRows, SideBySideDiffRow{OldLine: rawLine, OldLineNumber: oldLineNumber})
+			oldLineNumber++
+		case "+":
+			addedRows = append(addedRows, SideBySideDiffRow{NewLine: rawLine, NewLineNumber: newLineNumber})
+			newLineNumber++
+		case "\\":
+			// the no newline at end of file marker belong to the side of the line before it
+			switch {
+			case len(addedRows) > 0:
+				addedRows = append(addedRows, SideBySideDiffRow{NewLine: rawLine})
+			case len(removedRows) > 0:
+				removedRows = append(removedRows, SideBySideDiffRow{OldLine: rawLine})
+			default:
+				rows = append(rows, SideBySideDiffRow{OldLine: rawLine, NewLine: rawLine})
+			}
+		default:
+			// context line (an empty line within a hunk is a context line with empty content)
+			flushChangeBlock()
+			rows = append(rows, SideBySideDiffRow{
+				OldLine:       rawLine,
+				NewLine:       rawLine,
+				OldLineNumber: oldLineNumber,
+				NewLineNumber: newLineNumber,
+			})
+			oldLineNumber++
+			newLineNumber++
+		}
+	}
+	flushChangeBlock()
+
+	return rows, hasHunk
+}
+
+// return the position of every added or removed line within the hunk
+func (fd FileDiff) HunkChangeLines(hunkIndex int) []DiffLinePosition {
+	positions := []DiffLinePosition{}
+	if hunkIndex < 0 || hunkIndex >= len(fd.Hunks) {
+		return positions
+	}
+	for lineIndex, line := range fd.Hunks[hunkIndex].Lines {
+		if line.Kind == "+" || line.Kind == "-" {
+			positions = append(positions, DiffLinePosition{HunkIndex: hunkIndex, LineIndex: lineIndex})
+		}
+	}
+	return positions
+}
+
+// ----------------------------------
+//
+//	Stage or unstage only the selected lines of a file diff
+//	  - stage expects a diff between index and worktree
+//	  - unstage expects a diff between HEAD and index
+//	  - return the error of git apply, so it can be shown to the user
+//
+// ----------------------------------
+func (gf *GitFiles) StagePartialFileChanges(fileDiff FileDiff, selectedLines []DiffLinePosition) error {
+	if !gf.gitProcessLock.CanProceedWithGitOps() {
+		return errors.New(gf.gitProcessLock.OtherProcessRunningWarning())
+	}
+	defer gf.gitProcessLock.ReleaseGitOpsLock()
+
+	patch, ok := buildPartialPatch(fileDiff, selectedLines, false)
+	if !ok {
+		return nil
+	}
+	return gf.applyPatch(patch, []string{"apply", "--cached", "--recount", "--whitespace=nowarn", "-"})
+}
+
+func (gf *GitFiles) UnstagePartialFileChanges(fileDiff FileDiff, selectedLines []DiffLinePosition) error {
+	if !gf.gitProcessLock.CanProceedWithGitOps() {
+		return errors.New(gf.gitProcessLock.OtherProcessRunningWarning())
+	}
+	defer gf.gitProcessLock.ReleaseGitOpsLock()
+
+	patch, ok := buildPartialPatch(fileDiff, selectedLines, true)
+	if !ok {
+		return nil
+	}
+	return gf.applyPatch(patch, []string{"apply", "--cached", "--reverse", "--recount", "--whitespace=nowarn", "-"})
+}
+
+// ----------------------------------
+//
+//	Discard only the selected lines of a file diff
+//	  - the diff is expected to be between index and worktree,
+//	    or between HEAD and worktree when includeIndex is true (file has no unstaged changes)
+//	  - return the error of git apply, so it can be shown to the user
+//
+// ----------------------------------
+func (gf *GitFiles) DiscardPartialFileChanges(fileDiff FileDiff, selectedLines []DiffLinePosition, includeIndex bool) error {
+	if !gf.gitProcessLock.CanProceedWithGitOps() {
+		return errors.New(gf.gitProcessLock.OtherProcessRunningWarning())
+	}
+	defer gf.gitProcessLock.ReleaseGitOpsLock()
+
+	patch, ok := buildPartialPatch(fileDiff, selectedLines, true)
+	if !ok {
+		return nil
+	}
+	gitArgs := []string{"apply", "--reverse", "--recount", "--whitespace=nowarn", "-"}
+	if includeIndex {
+		gitArgs = []string{"apply", "--reverse", "--index", "--recount", "--whitespace=nowarn", "-"}
+	}
+	applyErr := gf.applyPatch(patch, gitArgs)
+
+	// changes that only touch the worktree will not trigger any write in .git folder,
+	// so we trigger a fetch here to prevent a "lag" in the UI
+	go func() {
+		gf.GetGitFilesStatus()
+		gf.updateChannel <- GIT_FILES_STATUS_UPDATE
+	}()
+	return applyErr
+}
+
+// the returned error is the output of git apply (eg, the patch does not apply), it is meant to be shown to the user
+func (gf *GitFiles) applyPatch(patch string, gitArgs []string) error {
+	cmdExecutor := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+	cmdExecutor.Stdin = strings.NewReader(patch)
+	gitOutput, err := cmdExecutor.CombinedOutput()
+	if err != nil {
+		gitOutputString := strings.TrimSpace(string(gitOutput))
+		gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT APPLY PATCH ERROR]: %w, %s", err, gitOutputString))
+		if gitOutputString == "" {
+			return err
+		}
+		return errors.New(gitOutputString)
+	}
+	return nil
+}
+
+// build a patch that only contains the selected lines,
+// the patch will always be in the forward direction (old -> new) of the given diff, when reverse is true
+// it is meant to be applied with `--reverse`
+//
+// unselected lines are handled like how `git add -p` does it:
+//   - forward: an unselected "-" line become context, an unselected "+" line is dropped
+//   - reverse: an unselected "+" line become context, an unselected "-" line is dropped
+func buildPartialPatch(fileDiff FileDiff, selectedLines []DiffLinePosition, reverse bool) (string, bool) {
+	selected := make(map[DiffLinePosition]bool, len(selectedLines))
+	for _, position := range selectedLines {
+		selected[position] = true
+	}
+
+	var hunksPatch strings.Builder
+	isPartial := false
+	hasSelectedChange := false
+	offset := 0
+
+	for hunkIndex, hunk := range fileDiff.Hunks {
+		var hunkLines []string
+		oldCount := 0
+		newCount := 0
+		hunkHasSelectedChange := false
+		previousLineKept := false
+
+		for lineIndex, line := range hunk.Lines {
+			isSelected := selected[DiffLinePosition{HunkIndex: hunkIndex, LineIndex: lineIndex}]
+			kind := line.Kind
+			switch kind {
+			case "+", "-":
+				if isSelected {
+					hunkHasSelectedChange = true
+				} else {
+					isPartial = true
+					if (kind == "+") == reverse {
+						kind = " "
+					} else {
+						previousLineKept = false
+						continue
+					}
+				}
+			case "\\":
+				if previousLineKept {
+					hunkLines = append(hunkLines, "\\"+line.Content)
+				}
+				continue
+			}
+
+			switch kind {
+			case " ":
+				oldCount++
+				newCount++
+			case "-":
+				oldCount++
+			case "+":
+				newCount++
+			}
+			hunkLines = append(hunkLines, kind+line.Content)
+			previousLineKept = true
+		}
+
+		if !hunkHasSelectedChange {
+			isPartial = isPartial || len(fileDiff.HunkChangeLines(hunkIndex)) > 0
+			continue
+		}
+		hasSelectedChange = true
+
+		// keep the start of the side the patch will be applied on, and shift the other side
+		// according to the hunks that were already written into the patch
+		// (an empty side of a hunk points to the line before it, so normalize to the first line first)
+		oldFirstLine := hunk.OldStart
+		if hunk.OldCount == 0 {
+			oldFirstLine++
+		}
+		newFirstLine := hunk.NewStart
+		if hunk.NewCount == 0 {
+			newFirstLine++
+		}
+		oldStart := oldFirstLine
+		newStart := oldFirstLine + offset
+		if reverse {
+			oldStart = newFirstLine - offset
+			newStart = newFirstLine
+		}
+		if oldCount == 0 {
+			oldStart = max(0, oldStart-1)
+		}
+		if newCount == 0 {
+			newStart = max(0, newStart-1)
+		}
+		offset += newCount - oldCount
+
+		hunksPatch.WriteString(fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", oldStart, oldCount, newStart, newCount))
+		for _, hunkLine := range hunkLines {
+			hunksPatch.WriteString(hunkLine + "\n")
+		}
+	}
+
+	if !hasSelectedChange {
+		return "", false
+	}
+
+	var patch strings.Builder
+	for _, headerLine := range partialPatchFileHeader(fileDiff.FileHeader, isPartial, reverse) {
+		patch.WriteString(headerLine + "\n")
+	}
+	patch.WriteString(hunksPatch.String())
+	return patch.String(), true
+}
+
+// a partial patch of a newly created file (when unstaging) or a deleted file (when staging) can't keep
+// the creation/deletion header, because the file will still exist after the patch was applied
+func partialPatchFileHeader(fileHeader []string, isPartial bool, reverse bool) []string {
+	isNewFile := false
+	isDeletedFile := false
+	oldPath := ""
+	newPath := ""
+	for _, line := range fileHeader {
+		switch {
+		case strings.HasPrefix(line, "new file mode"):
+			isNewFile = true
+		case strings.HasPrefix(line, "deleted file mode"):
+			isDeletedFile = true
+		case strings.HasPrefix(line, "--- a/"):
+			oldPath = strings.TrimPrefix(line, "--- a/")
+		case strings.HasPrefix(line, "+++ b/"):
+			newPath = strings.TrimPrefix(line, "+++ b/")
+		}
+	}
+
+	if !isPartial || !((isNewFile && reverse) || (isDeletedFile && !reverse)) {
+		return fileHeader
+	}
+
+	header := []string{}
+	for _, line := range fileHeader {
+		switch {
+		case strings.HasPrefix(line, "new file mode"), strings.HasPrefix(line, "deleted file mode"):
+			continue
+		case line == "--- /dev/null" || line == "--- NUL":
+			header = append(header, "--- a/"+newPath)
+		case line == "+++ /dev/null" || line == "+++ NUL":
+			header = append(header, "+++ b/"+oldPath)
+		default:
+			header = append(header, line)
+		}
+	}
+	return header
+}
+
+func atoiOrDefault(value string, defaultValue int) int {
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return defaultValue
+	}
+	return parsed
+}
@@ -0,0 +1,147 @@
+package git
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gohyuhan/gitti/executor"
+)
+
+// ----------------------------------
+//
+//	Compile a glob pattern into a regexp that match against the path of a file
+//	* "*" and "?" will not match across "/", "**" will match across any number of directories
+//	* a pattern without "/" will match the file name at any level (eg, *.pb.go), like .gitignore
+//	* a pattern without any glob character will also match everything under the directory
+//
+// ----------------------------------
+func compilePathspecGlob(pattern string) (*regexp.Regexp, error) {
+	pattern = strings.TrimPrefix(strings.TrimSpace(pattern), "./")
+	isDirectoryOrFile := !strings.ContainsAny(pattern, "*?[")
+	pattern = strings.TrimSuffix(pattern, "/")
+
+	var expression strings.Builder
+	expression.WriteString("^")
+	if !strings.Contains(pattern, "/") {
+		expression.WriteString("(?:.*/)?")
+	}
+	for index := 0; index < len(pattern); index++ {
+		char := pattern[index]
+		switch char {
+		case '*':
+			if strings.HasPrefix(pattern[index:], "**") {
+				index++
+				if strings.HasPrefix(pattern[index+1:], "/") {
+					// "**/" match zero or more directories
+					index++
+					expression.WriteString("(?:.*/)?")
+				} else {
+					expression.WriteString(".*")
+				}
+			} else {
+				expression.WriteString("[^/]*")
+			}
+		case '?':
+			expression.WriteString("[^/]")
+		case '[':
+			closingIndex := strings.IndexByte(pattern[index+1:], ']')
+			if closingIndex < 0 {
+				expression.WriteString(regexp.QuoteMeta(string(char)))
+				continue
+			}
+			class := pattern[index+1 : index+1+closingIndex]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			expression.WriteString("[" + strings.ReplaceAll(class, `\`, `\\`) + "]")
+			index += closingIndex + 1
+		default:
+			expression.WriteString(regexp.QuoteMeta(string(char)))
+		}
+	}
+	if isDirectoryOrFile {
+		expression.WriteString("(?:/.*)?")
+	}
+	expression.WriteString("$")
+
+	return regexp.Compile(expression.String())
+}
+
+// ----------------------------------
+//
+//	Return the modified files that match the glob pattern
+//	* a renamed file will also be matched by its original path
+//	* return false when the pattern is empty or invalid
+//
+// ----------------------------------
+func (gf *GitFiles) FilesMatchingPathspec(pattern string) ([]FileStatus, bool) {
+	if strings.TrimSpace(pattern) == "" {
+		return []FileStatus{}, false
+	}
+	globRegexp, err := compilePathspecGlob(pattern)
+	if err != nil {
+		return []FileStatus{}, false
+	}
+
+	matchedFiles := []FileStatus{}
+	for _, file := range gf.FilesStatus() {
+		// ignored files are only shown for inspection, they can't be staged like the others
+		if file.IndexState == "!" {
+			continue
+		}
+		if globRegexp.MatchString(file.FilePathname) || (file.OrigPath != "" && globRegexp.MatchString(file.OrigPath)) {
+			matchedFiles = append(matchedFiles, file)
+		}
+	}
+	return matchedFiles, true
+}
+
+// ----------------------------------
+//
+//	Stage several files at once, files in conflict state will be marked as resolved
+//	* the paths are passed as literal pathspecs, so a path with glob characters will not match other files
+//
+// ----------------------------------
+func (gf *GitFiles) StageFiles(filePathNames []string) {
+	if !gf.gitProcessLock.CanProceedWithGitOps() {
+		return
+	}
+	defer gf.gitProcessLock.ReleaseGitOpsLock()
+
+	files := gf.filesStatusOf(filePathNames)
+	if len(files) < 1 {
+		return
+	}
+
+	gitArgs := append([]string{"--literal-pathspecs", "add", "--all", "--"}, filesPathspecs(files)...)
+	cmdExecutor := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+	cmdExecutor.Run()
+}
+
+// ----------------------------------
+//
+//	Unstage several files at once, the changes are kept in the working tree
+//	* the paths are passed as literal pathspecs, so a path with glob characters will not match other files
+//
+// ----------------------------------
+func (gf *GitFiles) UnstageFiles(filePathNames []string) {
+	if !gf.gitProcessLock.CanProceedWithGitOps() {
+		return
+	}
+	defer gf.gitProcessLock.ReleaseGitOpsLock()
+
+	files := []FileStatus{}
+	for _, file := range gf.filesStatusOf(filePathNames) {
+		// untracked files have nothing to unstage
+		if file.IndexState != "?" {
+			files = append(files, file)
+		}
+	}
+	if len(files) < 1 {
+		return
+	}
+
+	gitArgs := append([]string{"--literal-pathspecs", "reset", "--quiet", "--"}, filesPathspecs(files)...)
+	cmdExecutor := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+	cmdExecutor.Run()
+}
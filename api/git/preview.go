@@ -0,0 +1,252 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gohyuhan/gitti/executor"
+)
+
+// what is known about one side (before or after) of a file without generating its diff
+type FileBlobPreview struct {
+	Exists      bool
+	Size        int64 // in bytes
+	MimeType    string
+	IsBinary    bool
+	ImageFormat string // empty when the content is not an image that can be decoded
+	ImageWidth  int
+	ImageHeight int
+}
+
+// the file on HEAD (before) and on the working tree (after)
+type FilePreview struct {
+	Old FileBlobPreview
+	New FileBlobPreview
+}
+
+// either side being binary will make git show "Binary files differ" instead of the diff
+func (fp FilePreview) IsBinary() bool {
+	return fp.Old.IsBinary || fp.New.IsBinary
+}
+
+// the diff of a large file should be streamed page by page instead of being loaded as a whole
+func (fp FilePreview) IsLarge() bool {
+	return max(fp.Old.Size, fp.New.Size) > LARGEFILEDIFFTHRESHOLD
+}
+
+// ----------------------------------
+//
+//	Look into the file on HEAD and on the working tree to decide how it should be previewed
+//	* only the beginning of the content will be read, the size is retrieved without loading the file
+//	* submodule will not be previewed, it will always be shown as a normal diff
+//
+// ----------------------------------
+func (gf *GitFiles) GetFilePreview(ctx context.Context, fileStatus FileStatus) FilePreview {
+	filePreview := FilePreview{}
+	if strings.HasPrefix(fileStatus.SubmoduleState, "S") {
+		return filePreview
+	}
+
+	if !isNewFileStatus(fileStatus) {
+		oldPathName := fileStatus.FilePathname
+		if fileStatus.OrigPath != "" {
+			oldPathName = fileStatus.OrigPath
+		}
+		filePreview.Old = gf.gitObjectBlobPreview(ctx, "HEAD:"+oldPathName)
+	}
+	filePreview.New = worktreeBlobPreview(filepath.Join(executor.GittiCmdExecutor.RepoPath(), fileStatus.FilePathname))
+
+	return filePreview
+}
+
+// the blob of a git object (eg, HEAD:<path>), it will not exist when the path is not on that commit or there is no commit yet
+func (gf *GitFiles) gitObjectBlobPreview(ctx context.Context, objectName string) FileBlobPreview {
+	blobPreview := FileBlobPreview{}
+
+	sizeCmdExecutor := executor.GittiCmdExecutor.RunGitCmdWithContext(ctx, []string{"cat-file", "-s", objectName}, false)
+	sizeOutput, err := sizeCmdExecutor.Output()
+	if err != nil {
+		return blobPreview
+	}
+	size, err := strconv.ParseInt(strings.TrimSpace(string(sizeOutput)), 10, 64)
+	if err != nil {
+		return blobPreview
+	}
+	blobPreview.Exists = true
+	blobPreview.Size = size
+
+	contentCmdExecutor := executor.GittiCmdExecutor.RunGitCmdWithContext(ctx, []string{"cat-file", "blob", objectName}, false)
+	stdout, err := contentCmdExecutor.StdoutPipe()
+	if err != nil {
+		gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT FILE PREVIEW ERROR]: %w", err))
+		return blobPreview
+	}
+	if err := contentCmdExecutor.Start(); err != nil {
+		gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT FILE PREVIEW ERROR]: %w", err))
+		return blobPreview
+	}
+	sniffFileBlob(stdout, &blobPreview)
+
+	// the rest of the content is not needed
+	_ = contentCmdExecutor.Process.Kill()
+	_ = contentCmdExecutor.Wait()
+
+	return blobPreview
+}
+
+// the file on the working tree, symlink and directory (eg, nested repository) will not be read
+func worktreeBlobPreview(filePath string) FileBlobPreview {
+	blobPreview := FileBlobPreview{}
+
+	fileInfo, err := os.Lstat(filePath)
+	if err != nil {
+		return blobPreview
+	}
+	blobPreview.Exists = true
+	blobPreview.Size = fileInfo.Size()
+	if !fileInfo.Mode().IsRegular() {
+		return blobPreview
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return blobPreview
+	}
+	defer file.Close()
+	sniffFileBlob(file, &blobPreview)
+
+	return blobPreview
+}
+
+// detect the content type from the beginning of the content,
+// the dimensions of an image will be decoded from its header without decoding the whole image
+func sniffFileBlob(reader io.Reader, blobPreview *FileBlobPreview) {
+	bufferedReader := bufio.NewReaderSize(reader, FILEPREVIEWSNIFFLENGTH)
+	head, _ := bufferedReader.Peek(FILEPREVIEWSNIFFLENGTH)
+
+	blobPreview.IsBinary = bytes.IndexByte(head, 0) >= 0
+	blobPreview.MimeType = http.DetectContentType(head)
+	if !strings.HasPrefix(blobPreview.MimeType, "image/") {
+		return
+	}
+
+	imageConfig, imageFormat, err := image.DecodeConfig(bufferedReader)
+	if err != nil {
+		return
+	}
+	blobPreview.IsBinary = true
+	blobPreview.ImageFormat = imageFormat
+	blobPreview.ImageWidth = imageConfig.Width
+	blobPreview.ImageHeight = imageConfig.Height
+}
+
+// ----------------------------------
+//
+//	Get a page of the file diff content by streaming it from git
+//	* the lines before the page are read and dropped, git will be stopped once the page is filled
+//	* a page other than the first will start with the file header and the header of the hunk it continue from,
+//	  so that it can still be highlighted and understood on its own
+//	* return true when there is still a next page
+//
+// ----------------------------------
+func (gf *GitFiles) GetFilesDiffInfoPage(ctx context.Context, fileStatus FileStatus, DiffType string, diffOptions DiffOptions, page int) ([]string, bool) {
+	gitArgs := filesDiffGitArgs(fileStatus, DiffType, diffOptions)
+
+	cmdExecutor := executor.GittiCmdExecutor.RunGitCmdWithContext(ctx, gitArgs, true)
+	stdout, err := cmdExecutor.StdoutPipe()
+	if err != nil {
+		gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT FILES DIFF ERROR]: %w", err))
+		return nil, false
+	}
+	if err := cmdExecutor.Start(); err != nil {
+		gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT FILES DIFF ERROR]: %w", err))
+		return nil, false
+	}
+	defer func() {
+		// the rest of the diff is not needed, stop git from generating it
+		_ = cmdExecutor.Process.Kill()
+		_ = cmdExecutor.Wait()
+	}()
+
+	pageStart := page * LARGEFILEDIFFPAGELINES
+	pageEnd := pageStart + LARGEFILEDIFFPAGELINES
+	fileHeaderLines := []string{}
+	lastHunkHeader := ""
+	pageLines := []string{}
+	hasNextPage := false
+
+	reader := bufio.NewReader(stdout)
+	for lineIndex := 0; ; lineIndex++ {
+		line, readErr := readDiffPageLine(reader)
+		if readErr != nil && line == "" {
+			break
+		}
+		if lineIndex >= pageEnd {
+			hasNextPage = true
+			break
+		}
+
+		isHunkHeader := hunkHeaderRegex.MatchString(ansiEscapeSequenceRegex.ReplaceAllString(line, ""))
+		if lineIndex < pageStart {
+			if isHunkHeader {
+				lastHunkHeader = line
+			} else if lastHunkHeader == "" {
+				fileHeaderLines = append(fileHeaderLines, line)
+			}
+			continue
+		}
+		if lineIndex == pageStart && page > 0 {
+			pageLines = append(pageLines, fileHeaderLines...)
+			if !isHunkHeader && lastHunkHeader != "" {
+				pageLines = append(pageLines, lastHunkHeader)
+			}
+		}
+		pageLines = append(pageLines, line)
+
+		if readErr != nil {
+			break
+		}
+	}
+
+	if ctx.Err() != nil {
+		gf.errorLog = append(gf.errorLog, fmt.Errorf("[FILE DIFF OPERATION CANCELLED DUE TO CONTEXT SWITCHING]: %w", ctx.Err()))
+		return nil, false
+	}
+	return pageLines, hasNextPage
+}
+
+// read a line without the line break, the part of the line beyond MAXDIFFPAGELINELENGTH will be dropped
+func readDiffPageLine(reader *bufio.Reader) (string, error) {
+	var line []byte
+	isTruncated := false
+	for {
+		chunk, err := reader.ReadSlice('\n')
+		if remaining := MAXDIFFPAGELINELENGTH - len(line); len(chunk) > remaining {
+			chunk = chunk[:remaining]
+			isTruncated = true
+		}
+		line = append(line, chunk...)
+		if err == bufio.ErrBufferFull {
+			continue
+		}
+
+		lineString := strings.TrimSuffix(string(line), "\n")
+		if isTruncated {
+			// the color of the line might have been cut in the middle
+			lineString += "\x1b[m"
+		}
+		return lineString, err
+	}
+}
@@ -0,0 +1,170 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gohyuhan/gitti/executor"
+)
+
+// a revision that a file can be restored from
+type RestoreSource struct {
+	Revision   string // the ref name or the commit hash to be passed to --source
+	SourceType string
+	Hash       string // the abbreviated hash of the commit that the revision points to
+	Subject    string
+}
+
+// ----------------------------------
+//
+//	Return the revisions that a file can be restored from
+//	* local branches, remote branches and tags come first, followed by the recent commits of the current branch
+//
+// ----------------------------------
+func (gf *GitFiles) RestoreSources() []RestoreSource {
+	restoreSources := []RestoreSource{}
+
+	gitArgs := []string{"for-each-ref", "--format=%(refname)%00%(refname:short)%00%(objectname:short)%00%(contents:subject)", "refs/heads", "refs/remotes", "refs/tags"}
+	cmdExecutor := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+	gitOutput, err := cmdExecutor.Output()
+	if err != nil {
+		gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT RESTORE SOURCES ERROR]: %w", err))
+	}
+	for _, line := range strings.Split(strings.TrimSpace(string(gitOutput)), "\n") {
+		parts := strings.SplitN(line, "\x00", 4)
+		if len(parts) < 4 {
+			continue
+		}
+		var sourceType string
+		switch {
+		case strings.HasPrefix(parts[0], "refs/heads/"):
+			sourceType = RESTORESOURCEBRANCH
+		case strings.HasPrefix(parts[0], "refs/remotes/"):
+			// the symbolic ref of the remote default branch point to a branch that is already listed
+			if strings.HasSuffix(parts[0], "/HEAD") {
+				continue
+			}
+			sourceType = RESTORESOURCEREMOTEBRANCH
+		default:
+			sourceType = RESTORESOURCETAG
+		}
+		restoreSources = append(restoreSources, RestoreSource{
+			Revision:   parts[1],
+			SourceType: sourceType,
+			Hash:       parts[2],
+			Subject:    parts[3],
+		})
+	}
+
+	gitArgs = []string{"log", "-n", fmt.Sprintf("%d", RESTORERECENTCOMMITSCOUNT), "--format=%H%x00%h%x00%s"}
+	cmdExecutor = executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+	gitOutput, err = cmdExecutor.Output()
+	if err != nil {
+		// an unborn branch has no commit yet
+		return restoreSources
+	}
+	for _, line := range strings.Split(strings.TrimSpace(string(gitOutput)), "\n") {
+		parts := strings.SplitN(line, "\x00", 3)
+		if len(parts) < 3 {
+			continue
+		}
+		restoreSources = append(restoreSources, RestoreSource{
+			Revision:   parts[0],
+			SourceType: RESTORESOURCECOMMIT,
+			Hash:       parts[1],
+			Subject:    parts[2],
+		})
+	}
+	return restoreSources
+}
+
+// ----------------------------------
+//
+//	Return the files changed by a commit
+//	* the files changed against any of the parents will be returned for a merge commit
+//
+// ----------------------------------
+func (gf *GitFiles) FilesChangedInCommit(commitHash string) []string {
+	gitArgs := []string{"diff-tree", "-r", "-m", "--root", "--no-commit-id", "--name-only", "-z", commitHash}
+	cmdExecutor := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+	gitOutput, err := cmdExecutor.Output()
+	if err != nil {
+		gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT COMMIT FILES ERROR]: %w", err))
+		return []string{}
+	}
+
+	files := []string{}
+	seen := make(map[string]bool)
+	for _, filePathName := range strings.Split(string(gitOutput), "\x00") {
+		if filePathName == "" || seen[filePathName] {
+			continue
+		}
+		seen[filePathName] = true
+		files = append(files, filePathName)
+	}
+	return files
+}
+
+// ----------------------------------
+//
+//	Return true if the file exist in the revision, the file will be removed if it is restored from a revision without it
+//
+// ----------------------------------
+func (gf *GitFiles) FileExistsInRevision(revision string, filePathName string) bool {
+	gitArgs := []string{"cat-file", "-e", revision + ":" + filePathName}
+	cmdExecutor := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+	return cmdExecutor.Run() == nil
+}
+
+// ----------------------------------
+//
+//	Return true if restoring the file to the target would overwrite its local changes
+//
+// ----------------------------------
+func (gf *GitFiles) HasLocalChangesFor(filePathName string, restoreTarget string) bool {
+	fileStatus, exist := gf.FileStatusOf(filePathName)
+	if !exist {
+		return false
+	}
+	hasStagedChanges := fileStatus.IndexState != " " && fileStatus.IndexState != "?" && fileStatus.IndexState != "!"
+	hasUnstagedChanges := fileStatus.WorkTree != " " && fileStatus.WorkTree != "!"
+	switch restoreTarget {
+	case RESTORETARGETSTAGED:
+		return hasStagedChanges
+	case RESTORETARGETBOTH:
+		return hasStagedChanges || hasUnstagedChanges
+	}
+	return hasUnstagedChanges
+}
+
+// ----------------------------------
+//
+//	Restore the file as of the revision to the working tree, the index or both
+//
+// ----------------------------------
+func (gf *GitFiles) GitRestoreFile(filePathName string, revision string, restoreTarget string) {
+	if !gf.gitProcessLock.CanProceedWithGitOps() {
+		return
+	}
+	defer gf.gitProcessLock.ReleaseGitOpsLock()
+
+	gitArgs := []string{"restore", "--source=" + revision}
+	switch restoreTarget {
+	case RESTORETARGETSTAGED:
+		gitArgs = append(gitArgs, "--staged")
+	case RESTORETARGETBOTH:
+		gitArgs = append(gitArgs, "--staged", "--worktree")
+	}
+	gitArgs = append(gitArgs, "--", filePathName)
+
+	cmdExecutor := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+	if gitOutput, err := cmdExecutor.CombinedOutput(); err != nil {
+		gf.errorLog = append(gf.errorLog, fmt.Errorf("[GIT RESTORE ERROR]: %w, %s", err, strings.TrimSpace(string(gitOutput))))
+	}
+
+	// the working tree is not watched, refresh the files status once it was restored
+	go func() {
+		gf.GetGitFilesStatus()
+		gf.updateChannel <- GIT_FILES_STATUS_UPDATE
+	}()
+}
@@ -12,6 +12,7 @@ import (
 
 type StashInfo struct {
 	Id      string
+	Hash    string // the commit hash of the stash, it stays the same when the stash index shifts
 	Message string
 }
 
@@ -43,7 +44,7 @@ func (gs *GitStash) AllStash() []StashInfo {
 //
 // ----------------------------------
 func (gs *GitStash) GetLatestStashInfo() {
-	gitArgs := []string{"stash", "list", "--format=%gd %s"}
+	gitArgs := []string{"stash", "list", "--format=%gd %H %s"}
 	stashInfoCmdExecutor := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
 	stashInfoOutput, stashInfoErr := stashInfoCmdExecutor.Output()
 	if stashInfoErr != nil {
@@ -57,13 +58,14 @@ func (gs *GitStash) GetLatestStashInfo() {
 
 	var stashInfoArray []StashInfo
 	for _, stashInfo := range parsedStashInfo {
-		parsedInfo := strings.SplitN(stashInfo, " ", 2)
-		if len(parsedInfo) < 2 {
+		parsedInfo := strings.SplitN(stashInfo, " ", 3)
+		if len(parsedInfo) < 3 {
 			continue
 		}
 		stashInfoArray = append(stashInfoArray, StashInfo{
 			Id:      strings.TrimSpace(parsedInfo[0]),
-			Message: strings.TrimSpace(parsedInfo[1]),
+			Hash:    strings.TrimSpace(parsedInfo[1]),
+			Message: strings.TrimSpace(parsedInfo[2]),
 		})
 	}
 
@@ -103,27 +105,39 @@ func (gs *GitStash) GitStashAll(message string) ([]string, int) {
 // # Stash File changes
 //
 // ----------------------------------
-func (gs *GitStash) GitStashFile(filePathName string, message string) ([]string, int) {
+func (gs *GitStash) GitStashFile(fileStatus FileStatus, message string) ([]string, int) {
+	return gs.GitStashFiles([]FileStatus{fileStatus}, message)
+}
+
+// ----------------------------------
+//
+// # Stash changes of several files at once
+//
+// ----------------------------------
+func (gs *GitStash) GitStashFiles(filesStatus []FileStatus, message string) ([]string, int) {
 	if !gs.gitProcessLock.CanProceedWithGitOps() {
 		return []string{gs.gitProcessLock.OtherProcessRunningWarning()}, -1
 	}
 	defer gs.gitProcessLock.ReleaseGitOpsLock()
 
-	// Parse renamed/copied file format (old -> new)
-	actualFileName := filePathName
-	if strings.Contains(filePathName, "->") {
-		parts := strings.Split(filePathName, "->")
-		if len(parts) >= 2 {
-			actualFileName = strings.TrimSpace(parts[1])
+	// a renamed file need both the old and new path to be stashed, else the removal of the old path will be left behind
+	filePathNames := []string{}
+	for _, fileStatus := range filesStatus {
+		filePathNames = append(filePathNames, fileStatus.FilePathname)
+		if fileStatus.OrigPath != "" && fileStatus.IndexState == "R" {
+			filePathNames = append(filePathNames, fileStatus.OrigPath)
 		}
 	}
 
+	// the paths are passed as literal pathspecs, so a path with glob characters will not stash other files
 	var gitArgs []string
 	if message == "" {
-		gitArgs = []string{"stash", "push", "-u", actualFileName}
+		gitArgs = []string{"--literal-pathspecs", "stash", "push", "-u"}
 	} else {
-		gitArgs = []string{"stash", "push", "-u", "-m", message, actualFileName}
+		gitArgs = []string{"--literal-pathspecs", "stash", "push", "-u", "-m", message}
 	}
+	gitArgs = append(gitArgs, "--")
+	gitArgs = append(gitArgs, filePathNames...)
 
 	stashCmdExecutor := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
 	stashOutput, stashErr := stashCmdExecutor.CombinedOutput()
@@ -229,7 +243,7 @@ func (gs *GitStash) GitStashDrop(stashId string) ([]string, int) {
 // # Git stash detail
 //
 // ----------------------------------
-func (gs *GitStash) GitStashDetail(ctx context.Context, stashId string) []string {
+func (gs *GitStash) GitStashDetail(ctx context.Context, stashId string, diffOptions DiffOptions) []string {
 	var parsedDetail []string
 
 	// Use -p flag for small stashes to show patch details
@@ -240,9 +254,11 @@ func (gs *GitStash) GitStashDetail(ctx context.Context, stashId string) []string
 		return parsedDetail
 	}
 	if isSmall {
-		gitArgs = []string{"stash", "show", "-p", "-u", stashId}
+		gitArgs = append([]string{"stash", "show"}, diffOptions.PatchGitArgs()...)
+		gitArgs = append(gitArgs, "-p", "-u", stashId)
 	} else {
-		gitArgs = []string{"stash", "show", "-u", stashId}
+		gitArgs = append([]string{"stash", "show"}, diffOptions.GitArgs()...)
+		gitArgs = append(gitArgs, "-u", stashId)
 	}
 
 	detailCmdExecutor := executor.GittiCmdExecutor.RunGitCmdWithContext(ctx, gitArgs, true)
@@ -10,4 +10,6 @@ const (
 	GIT_LOG_UPDATE                             = "GIT_LOG_UPDATE"
 	GIT_FILES_STATUS_UPDATE                    = "GIT_FILES_STATUS_UPDATE"
 	GIT_REMOTE_SYNC_STATUS_AND_UPSTREAM_UPDATE = "GIT_REMOTE_SYNC_STATUS_AND_UPSTREAM_UPDATE"
+	GIT_REPO_OPERATION_STATE_UPDATE            = "GIT_REPO_OPERATION_STATE_UPDATE"
+	GIT_REPO_OPERATION_OUTPUT_UPDATE           = "GIT_REPO_OPERATION_OUTPUT_UPDATE"
 )
@@ -0,0 +1,41 @@
+package git
+
+import (
+	"os/exec"
+
+	"github.com/gohyuhan/gitti/executor"
+)
+
+// ----------------------------------
+//
+//	Return the command to launch the merge tool configured in git config (merge.tool) for a conflicted file
+//	* the command will need the terminal, so it is up to the caller to hand over the terminal while it run
+//
+// ----------------------------------
+func (gf *GitFiles) GitMergeToolCmd(filePathName string) *exec.Cmd {
+	gitArgs := []string{"mergetool", "--", filePathName}
+	return executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+}
+
+// ----------------------------------
+//
+//	Return the command to launch the diff tool configured in git config (diff.tool) for a modified file
+//	* the staged changes will be compared when the file has no unstaged changes
+//	* return nil for untracked or ignored file as there is nothing to compare against
+//
+// ----------------------------------
+func (gf *GitFiles) GitDiffToolCmd(fileStatus FileStatus) *exec.Cmd {
+	if fileStatus.IndexState == "?" || fileStatus.IndexState == "!" {
+		return nil
+	}
+
+	gitArgs := []string{"difftool"}
+	if fileStatus.WorkTree == " " {
+		gitArgs = append(gitArgs, "--cached")
+	}
+	gitArgs = append(gitArgs, "--", fileStatus.FilePathname)
+	if fileStatus.OrigPath != "" {
+		gitArgs = append(gitArgs, fileStatus.OrigPath)
+	}
+	return executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
+}
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/gohyuhan/gitti/executor"
@@ -19,6 +20,11 @@ func processGeneralGitOpsOutputIntoStringArray(dirtyGitOutput []byte) []string {
 	return cleanedStringArray
 }
 
+// only the final newline is removed from the diff output, the trailing whitespace of the last line is part of the patch that will be built from it
+func processGitDiffOutputIntoStringArray(gitDiffOutput []byte) []string {
+	return strings.Split(strings.TrimSuffix(string(gitDiffOutput), "\n"), "\n")
+}
+
 func splitOnCarriageReturnOrNewline(data []byte, atEOF bool) (advance int, token []byte, err error) {
 	if atEOF && len(data) == 0 {
 		return 0, nil, nil
@@ -159,6 +165,124 @@ func hasUpstreamWithIcon() (string, string, bool) {
 	return remoteIcon, upStream, upStreamExist
 }
 
+// parse the output of `git status --porcelain=v2 -z`
+//
+//	1 <XY> <sub> <mH> <mI> <mW> <hH> <hI> <path>
+//	2 <XY> <sub> <mH> <mI> <mW> <hH> <hI> <X><score> <path>\0<origPath>
+//	u <XY> <sub> <m1> <m2> <m3> <mW> <h1> <h2> <h3> <path>
+//	? <path>
+//	! <path>   (only with --ignored)
+//
+// paths are never quoted with -z, so they can contain spaces, "->" or non-ASCII characters as it is
+func parsePorcelainV2FilesStatus(gitOutput []byte) []FileStatus {
+	filesStatus := []FileStatus{}
+	entries := strings.Split(string(gitOutput), "\x00")
+
+	for index := 0; index < len(entries); index++ {
+		entry := entries[index]
+		if len(entry) < 2 {
+			continue
+		}
+
+		switch entry[0] {
+		case '1':
+			fields := strings.SplitN(entry, " ", 9)
+			if len(fields) < 9 {
+				continue
+			}
+			indexState, workTree := porcelainV2States(fields[1])
+			filesStatus = append(filesStatus, FileStatus{
+				FilePathname:     fields[8],
+				IndexState:       indexState,
+				WorkTree:         workTree,
+				HasConflict:      isFilesInConflictState(indexState, workTree),
+				SubmoduleState:   fields[2],
+				HeadFileMode:     fields[3],
+				IndexFileMode:    fields[4],
+				WorkTreeFileMode: fields[5],
+			})
+		case '2':
+			fields := strings.SplitN(entry, " ", 10)
+			if len(fields) < 10 {
+				continue
+			}
+			// the original path is the next NUL separated entry
+			origPath := ""
+			if index+1 < len(entries) {
+				index++
+				origPath = entries[index]
+			}
+			indexState, workTree := porcelainV2States(fields[1])
+			renameScore := 0
+			if len(fields[8]) > 1 {
+				renameScore, _ = strconv.Atoi(fields[8][1:])
+			}
+			filesStatus = append(filesStatus, FileStatus{
+				FilePathname:     fields[9],
+				OrigPath:         origPath,
+				IndexState:       indexState,
+				WorkTree:         workTree,
+				HasConflict:      isFilesInConflictState(indexState, workTree),
+				RenameScore:      renameScore,
+				SubmoduleState:   fields[2],
+				HeadFileMode:     fields[3],
+				IndexFileMode:    fields[4],
+				WorkTreeFileMode: fields[5],
+			})
+		case 'u':
+			fields := strings.SplitN(entry, " ", 11)
+			if len(fields) < 11 {
+				continue
+			}
+			indexState, workTree := porcelainV2States(fields[1])
+			filesStatus = append(filesStatus, FileStatus{
+				FilePathname:     fields[10],
+				IndexState:       indexState,
+				WorkTree:         workTree,
+				HasConflict:      true,
+				SubmoduleState:   fields[2],
+				HeadFileMode:     fields[3],
+				IndexFileMode:    fields[4],
+				WorkTreeFileMode: fields[6],
+			})
+		case '?':
+			filesStatus = append(filesStatus, FileStatus{
+				FilePathname:   entry[2:],
+				IndexState:     "?",
+				WorkTree:       "?",
+				HasConflict:    false,
+				SubmoduleState: "N...",
+			})
+		case '!':
+			filesStatus = append(filesStatus, FileStatus{
+				FilePathname:   entry[2:],
+				IndexState:     "!",
+				WorkTree:       "!",
+				HasConflict:    false,
+				SubmoduleState: "N...",
+			})
+		}
+	}
+
+	return filesStatus
+}
+
+// porcelain v2 use "." for an unmodified state, we keep using " " like porcelain v1 does
+func porcelainV2States(xy string) (string, string) {
+	if len(xy) < 2 {
+		return " ", " "
+	}
+	indexState := string(xy[0])
+	workTree := string(xy[1])
+	if indexState == "." {
+		indexState = " "
+	}
+	if workTree == "." {
+		workTree = " "
+	}
+	return indexState, workTree
+}
+
 // check if a file is in a conflict state
 func isFilesInConflictState(indexState string, workTree string) bool {
 	combinedState := indexState + workTree
@@ -184,3 +308,44 @@ func gitFetch() {
 	fetchCmdExecutor := executor.GittiCmdExecutor.RunGitCmd(gitArgs, false)
 	fetchCmdExecutor.Run()
 }
+
+type numstatEntry struct {
+	filePathName string
+	added        int
+	removed      int
+	isBinary     bool
+}
+
+// parse the output of git diff --numstat -z
+//
+//	<added>\t<removed>\t<path>\0
+//	<added>\t<removed>\t\0<orig path>\0<path>\0   (rename or copy)
+//
+// binary files have "-" for both counts
+func parseNumstat(gitOutput []byte) []numstatEntry {
+	entries := []numstatEntry{}
+	fields := strings.Split(string(gitOutput), "\x00")
+	for index := 0; index < len(fields); index++ {
+		parts := strings.SplitN(fields[index], "\t", 3)
+		if len(parts) < 3 {
+			continue
+		}
+		entry := numstatEntry{filePathName: parts[2]}
+		if parts[2] == "" {
+			// the orig path and the path follow as their own fields
+			if index+2 >= len(fields) {
+				break
+			}
+			entry.filePathName = fields[index+2]
+			index += 2
+		}
+		if parts[0] == "-" && parts[1] == "-" {
+			entry.isBinary = true
+		} else {
+			entry.added, _ = strconv.Atoi(parts[0])
+			entry.removed, _ = strconv.Atoi(parts[1])
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
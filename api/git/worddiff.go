@@ -0,0 +1,185 @@
+package git
+
+import (
+	"unicode"
+)
+
+const (
+	MAXWORDDIFFTOKENS       = 256 // the line will not be word diffed when any side have more tokens than this
+	MINWORDDIFFSIMILARRATIO = 0.4 // the line will not be word diffed when the unchanged part is less than this ratio
+)
+
+// a removed line and the added line that replaced it within the same change block of a hunk
+type DiffChangeLinePair struct {
+	OldRawIndex int    // position of the removed line within the diff lines
+	NewRawIndex int    // position of the added line within the diff lines
+	OldContent  string // the removed line content without the leading "-" and color
+	NewContent  string // the added line content without the leading "+" and color
+}
+
+// a piece of a line content, either changed or unchanged compared to the line that it was paired with
+type WordDiffSegment struct {
+	Text      string
+	IsChanged bool
+}
+
+// ----------------------------------
+//
+//	Pair up the removed and added lines of every change block within the hunks
+//	* it work for any output that contain diff (git diff, git show, git stash show -p)
+//	* the n-th removed line of a change block will be paired with the n-th added line of the same block
+//
+// ----------------------------------
+func PairDiffChangeLines(diffLines []string) []DiffChangeLinePair {
+	pairs := []DiffChangeLinePair{}
+	inHunk := false
+	removedLineIndexes := []int{}
+	addedLineIndexes := []int{}
+	strippedLines := make([]string, len(diffLines))
+
+	flushChangeBlock := func() {
+		for index := range min(len(removedLineIndexes), len(addedLineIndexes)) {
+			oldRawIndex := removedLineIndexes[index]
+			newRawIndex := addedLineIndexes[index]
+			pairs = append(pairs, DiffChangeLinePair{
+				OldRawIndex: oldRawIndex,
+				NewRawIndex: newRawIndex,
+				OldContent:  strippedLines[oldRawIndex][1:],
+				NewContent:  strippedLines[newRawIndex][1:],
+			})
+		}
+		removedLineIndexes = removedLineIndexes[:0]
+		addedLineIndexes = addedLineIndexes[:0]
+	}
+
+	for index, rawLine := range diffLines {
+		line := ansiEscapeSequenceRegex.ReplaceAllString(rawLine, "")
+		strippedLines[index] = line
+
+		if hunkHeaderRegex.MatchString(line) {
+			flushChangeBlock()
+			inHunk = true
+			continue
+		}
+		if !inHunk {
+			continue
+		}
+
+		kind := ""
+		if len(line) > 0 {
+			kind = line[:1]
+		}
+		switch kind {
+		case "-":
+			// a removed line after the added lines start a new change block
+			if len(addedLineIndexes) > 0 {
+				flushChangeBlock()
+			}
+			removedLineIndexes = append(removedLineIndexes, index)
+		case "+":
+			addedLineIndexes = append(addedLineIndexes, index)
+		case "\\":
+			// no newline at end of file marker, the change block still continue after it
+		case " ", "":
+			flushChangeBlock()
+		default:
+			// a line that doesn't belong to a hunk (eg, the start of the next file diff) end the current hunk
+			flushChangeBlock()
+			inHunk = false
+		}
+	}
+	flushChangeBlock()
+
+	return pairs
+}
+
+// ----------------------------------
+//
+//	Compare the content of a removed line and the added line that replaced it word by word
+//	* return false when both lines are too different (or too long) for the word diff to be meaningful
+//
+// ----------------------------------
+func DiffLineWordChanges(oldContent string, newContent string) ([]WordDiffSegment, []WordDiffSegment, bool) {
+	oldTokens := splitWordDiffTokens(oldContent)
+	newTokens := splitWordDiffTokens(newContent)
+	if len(oldTokens) > MAXWORDDIFFTOKENS || len(newTokens) > MAXWORDDIFFTOKENS {
+		return nil, nil, false
+	}
+
+	// longest common subsequence of the tokens
+	lcsLength := make([][]int, len(oldTokens)+1)
+	for index := range lcsLength {
+		lcsLength[index] = make([]int, len(newTokens)+1)
+	}
+	for oldIndex := len(oldTokens) - 1; oldIndex >= 0; oldIndex-- {
+		for newIndex := len(newTokens) - 1; newIndex >= 0; newIndex-- {
+			if oldTokens[oldIndex] == newTokens[newIndex] {
+				lcsLength[oldIndex][newIndex] = lcsLength[oldIndex+1][newIndex+1] + 1
+			} else {
+				lcsLength[oldIndex][newIndex] = max(lcsLength[oldIndex+1][newIndex], lcsLength[oldIndex][newIndex+1])
+			}
+		}
+	}
+
+	oldSegments := []WordDiffSegment{}
+	newSegments := []WordDiffSegment{}
+	unchangedLength := 0
+	oldIndex, newIndex := 0, 0
+	for oldIndex < len(oldTokens) || newIndex < len(newTokens) {
+		switch {
+		case oldIndex < len(oldTokens) && newIndex < len(newTokens) && oldTokens[oldIndex] == newTokens[newIndex]:
+			oldSegments = appendWordDiffSegment(oldSegments, oldTokens[oldIndex], false)
+			newSegments = appendWordDiffSegment(newSegments, newTokens[newIndex], false)
+			unchangedLength += len(oldTokens[oldIndex])
+			oldIndex++
+			newIndex++
+		case newIndex >= len(newTokens) || (oldIndex < len(oldTokens) && lcsLength[oldIndex+1][newIndex] >= lcsLength[oldIndex][newIndex+1]):
+			oldSegments = appendWordDiffSegment(oldSegments, oldTokens[oldIndex], true)
+			oldIndex++
+		default:
+			newSegments = appendWordDiffSegment(newSegments, newTokens[newIndex], true)
+			newIndex++
+		}
+	}
+
+	totalLength := len(oldContent) + len(newContent)
+	if totalLength > 0 && float64(unchangedLength*2)/float64(totalLength) < MINWORDDIFFSIMILARRATIO {
+		return nil, nil, false
+	}
+	return oldSegments, newSegments, true
+}
+
+// split the line into words (letters, digits and underscore), whitespace runs and single symbol characters
+func splitWordDiffTokens(content string) []string {
+	tokens := []string{}
+	runes := []rune(content)
+	for start := 0; start < len(runes); {
+		end := start + 1
+		switch {
+		case isWordDiffWordRune(runes[start]):
+			for end < len(runes) && isWordDiffWordRune(runes[end]) {
+				end++
+			}
+		case unicode.IsSpace(runes[start]):
+			for end < len(runes) && unicode.IsSpace(runes[end]) {
+				end++
+			}
+		}
+		tokens = append(tokens, string(runes[start:end]))
+		start = end
+	}
+	return tokens
+}
+
+func isWordDiffWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// merge with the last segment when both are in the same changed state
+func appendWordDiffSegment(segments []WordDiffSegment, text string, isChanged bool) []WordDiffSegment {
+	if len(segments) > 0 && segments[len(segments)-1].IsChanged == isChanged {
+		segments[len(segments)-1].Text += text
+		return segments
+	}
+	return append(segments, WordDiffSegment{Text: text, IsChanged: isChanged})
+}
@@ -3,13 +3,15 @@ package api
 import "github.com/gohyuhan/gitti/api/git"
 
 type GitOperations struct {
-	GitBranch    *git.GitBranch
-	GitCommit    *git.GitCommit
-	GitFiles     *git.GitFiles
-	GitPull      *git.GitPull
-	GitStash     *git.GitStash
-	GitRemote    *git.GitRemote
-	GitCommitLog *git.GitCommitLog
+	GitBranch        *git.GitBranch
+	GitCommit        *git.GitCommit
+	GitFiles         *git.GitFiles
+	GitPull          *git.GitPull
+	GitStash         *git.GitStash
+	GitRemote        *git.GitRemote
+	GitCommitLog     *git.GitCommitLog
+	GitBlame         *git.GitBlame
+	GitRepoOperation *git.GitRepoOperation
 }
 
 type GitRepoPath struct {
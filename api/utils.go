@@ -63,16 +63,18 @@ func PromptUserForGitInitConfirmation(repoPath string) {
 	}
 }
 
-func InitGitOperations(updateChannel chan string) *GitOperations {
+func InitGitOperations(updateChannel chan string, absoluteGitRepoPath string) *GitOperations {
 	gitProcessLock := git.InitGitProcessLock()
 	return &GitOperations{
-		GitBranch:    git.InitGitBranch(gitProcessLock),
-		GitCommit:    git.InitGitCommit(updateChannel, gitProcessLock),
-		GitFiles:     git.InitGitFile(updateChannel, gitProcessLock),
-		GitPull:      git.InitGitPull(updateChannel, gitProcessLock),
-		GitStash:     git.InitGitStash(gitProcessLock),
-		GitRemote:    git.InitGitRemote(updateChannel, gitProcessLock),
-		GitCommitLog: git.InitGitCommitLog(updateChannel, gitProcessLock),
+		GitBranch:        git.InitGitBranch(gitProcessLock),
+		GitCommit:        git.InitGitCommit(updateChannel, gitProcessLock),
+		GitFiles:         git.InitGitFile(updateChannel, gitProcessLock),
+		GitPull:          git.InitGitPull(updateChannel, gitProcessLock),
+		GitStash:         git.InitGitStash(gitProcessLock),
+		GitRemote:        git.InitGitRemote(updateChannel, gitProcessLock),
+		GitCommitLog:     git.InitGitCommitLog(updateChannel, gitProcessLock),
+		GitBlame:         git.InitGitBlame(gitProcessLock),
+		GitRepoOperation: git.InitGitRepoOperation(absoluteGitRepoPath, updateChannel, gitProcessLock),
 	}
 }
 
@@ -44,3 +44,7 @@ func (c *CmdExecutor) RunGitCmdWithContext(ctx context.Context, gitArgs []string
 func (c *CmdExecutor) UpdateRepoPath(updatedRepoPath string) {
 	c.repoPath = updatedRepoPath
 }
+
+func (c *CmdExecutor) RepoPath() string {
+	return c.repoPath
+}
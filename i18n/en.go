@@ -77,6 +77,8 @@ var eN = LanguageMapping{
 		"[space] stage this change",
 		"[e] edit",
 		"[r] resolve conflict",
+		"[T] merge tool",
+		"[g] match pattern",
 		"[enter] view modified content",
 		"[?] global key binding",
 	},
@@ -86,6 +88,9 @@ var eN = LanguageMapping{
 		"[s] stash",
 		"[S] stash all changes",
 		"[d] discard changes",
+		"[T] diff tool",
+		"[f] restore from revision",
+		"[g] match pattern",
 		"[enter] view modified content",
 		"[?] global key binding",
 	},
@@ -95,20 +100,83 @@ var eN = LanguageMapping{
 		"[s] stash",
 		"[S] stash all changes",
 		"[d] discard changes",
+		"[i] ignore (untracked)",
+		"[T] diff tool",
+		"[I] show/hide ignored",
+		"[f] restore from revision",
+		"[g] match pattern",
 		"[enter] view modified content",
 		"[?] global key binding",
 	},
 	KeyBindingModifiedFilesComponentNone: []string{
 		"[?] global key binding",
 	},
+	KeyBindingModifiedFilesComponentDirectory: []string{
+		"[space] stage/unstage directory",
+		"[d] discard directory changes",
+		"[enter] expand/collapse",
+		"[t] toggle tree view",
+		"[?] global key binding",
+	},
+	KeyBindingModifiedFilesComponentIgnored: []string{
+		"[I] hide ignored files",
+		"[t] toggle tree view",
+		"[enter] view matched ignore rule",
+		"[?] global key binding",
+	},
 	KeyBindingCommitLogComponent: []string{
 		"[↑/↓] move up and down",
-		"[enter] view commit log content",
+		"[enter] view changed files",
+		"[f] restore a file as of this commit",
+		"[/] filter commit log",
+		"[G] graph scope",
+		"[?] global key binding",
+	},
+	KeyBindingCommitLogComponentFiltered: []string{
+		"[↑/↓] move up and down",
+		"[enter] view changed files",
+		"[f] restore a file as of this commit",
+		"[/] change filter",
+		"[esc] clear filter",
+		"[G] graph scope",
+		"[?] global key binding",
+	},
+	KeyBindingCommitLogComponentFilesView: []string{
+		"[↑/↓] move up and down",
+		"[enter] view file diff",
+		"[f] restore a file as of this commit",
+		"[esc] back to commit log",
 		"[?] global key binding",
 	},
 	KeyBindingKeyDetailComponent: []string{
 		"[←/→] move left and right",
 		"[↑/↓] move up and down",
+		"[|] toggle side by side diff",
+		"[esc] back",
+		"[?] global key binding",
+	},
+	KeyBindingKeyDetailComponentFileDiff: []string{
+		"[↑/↓] move between hunks/lines",
+		"[v] toggle hunk/line mode",
+		"[space] stage/unstage hunk/line",
+		"[d] discard hunk/line",
+		"[←/→] move left and right",
+		"[|] toggle side by side diff",
+		"[esc] back",
+		"[?] global key binding",
+	},
+	KeyBindingKeyDetailComponentPagedDiff: []string{
+		"[←/→] move left and right",
+		"[↑/↓] move up and down",
+		"[<] previous page",
+		"[>] next page",
+		"[esc] back",
+		"[?] global key binding",
+	},
+	KeyBindingKeyDetailComponentBlame: []string{
+		"[↑/↓] move between lines",
+		"[enter] go to commit",
+		"[b] back to diff",
 		"[esc] back",
 		"[?] global key binding",
 	},
@@ -123,6 +191,26 @@ var eN = LanguageMapping{
 	KeyBindingKeyStashComponentNone: []string{
 		"[?] global key binding",
 	},
+	KeyBindingLocalBranchComponentMarked: []string{
+		"[d] delete marked branches",
+		"[m] mark/unmark",
+		"[M] clear marks",
+		"[?] global key binding",
+	},
+	KeyBindingModifiedFilesComponentMarked: []string{
+		"[space] stage/unstage marked files",
+		"[s] stash marked files",
+		"[d] discard marked files",
+		"[m] mark/unmark",
+		"[M] clear marks",
+		"[?] global key binding",
+	},
+	KeyBindingKeyStashComponentMarked: []string{
+		"[d] discard marked stashes",
+		"[m] mark/unmark",
+		"[M] clear marks",
+		"[?] global key binding",
+	},
 	KeyBindingForCommitPopUp: []string{
 		"[tab] move to next input",
 		"[shift+tab] move to previous input",
@@ -213,6 +301,106 @@ var eN = LanguageMapping{
 	KeyBindingForCreateBranchBasedOnRemoteOutputPopUp: []string{
 		"[esc] close",
 	},
+	KeyBindingForGitBatchOperationConfirmPromptPopUp: []string{
+		"[enter] proceed",
+		"[esc] cancel / close",
+	},
+	KeyBindingForGitBatchOperationOutputPopUp: []string{
+		"[esc] close",
+	},
+	KeyBindingForGitConflictEditorPopUp: []string{
+		"[↑/↓] previous/next block",
+		"[1] ours",
+		"[2] theirs",
+		"[3] both",
+		"[4] base",
+		"[backspace] undo",
+		"[enter] write back",
+		"[esc] cancel",
+	},
+	KeyBindingForGitRepoOperationOptionPopUp: []string{
+		"[↑/↓] move up and down",
+		"[enter] select action",
+		"[esc] cancel / close",
+	},
+	KeyBindingForGitRepoOperationOutputPopUp: []string{
+		"[esc] close",
+	},
+	KeyBindingForGitMergeToolResultPopUp: []string{
+		"[enter/esc] close",
+	},
+	KeyBindingForGitPathspecPopUp: []string{
+		"[enter] choose action for matched files",
+		"[esc] cancel",
+	},
+	KeyBindingForGitPathspecActionOptionPopUp: []string{
+		"[↑/↓] move up and down",
+		"[enter] select action",
+		"[esc] cancel / close",
+	},
+	KeyBindingForGitIgnoreRuleOptionPopUp: []string{
+		"[↑/↓] move up and down",
+		"[enter] choose rule",
+		"[esc] cancel / close",
+	},
+	KeyBindingForGitIgnoreTargetOptionPopUp: []string{
+		"[↑/↓] move up and down",
+		"[enter] write rule",
+		"[esc] cancel / close",
+	},
+	KeyBindingForGitCleanPopUp: []string{
+		"[↑/↓] move up and down",
+		"[space] check / uncheck",
+		"[A] check / uncheck all",
+		"[d] directories",
+		"[x] ignored files",
+		"[enter] remove checked",
+		"[esc] cancel",
+	},
+	KeyBindingForGitRestoreSourcePopUp: []string{
+		"[↑/↓] move up and down",
+		"[enter] choose revision",
+		"[esc] cancel / close",
+	},
+	KeyBindingForGitRestoreFilePopUp: []string{
+		"[↑/↓] move up and down",
+		"[enter] choose file",
+		"[esc] cancel / close",
+	},
+	KeyBindingForGitRestoreTargetOptionPopUp: []string{
+		"[↑/↓] move up and down",
+		"[enter] restore",
+		"[esc] cancel / close",
+	},
+	KeyBindingForGitRestoreConfirmPromptPopUp: []string{
+		"[enter] proceed with restore",
+		"[esc] cancel / close",
+	},
+	KeyBindingForGitCommitLogFilterPopUp: []string{
+		"[tab] move to next input",
+		"[shift+tab] move to previous input",
+		"[enter] apply filter (all empty to clear)",
+		"[esc] cancel / close",
+	},
+	KeyBindingForGitCommitLogScopeOptionPopUp: []string{
+		"[↑/↓] move up and down",
+		"[enter] select",
+		"[esc] cancel / close",
+	},
+	KeyBindingForGitCommitLogScopeBranchesPopUp: []string{
+		"[↑/↓] move up and down",
+		"[space] check / uncheck",
+		"[enter] draw graph for checked",
+		"[esc] cancel / close",
+	},
+	KeyBindingForGitCommitParentOptionPopUp: []string{
+		"[↑/↓] move up and down",
+		"[enter] diff against this parent",
+		"[esc] cancel / close",
+	},
+	KeyBindingForGitApplyPatchErrorPopUp: []string{
+		"[enter/esc] close",
+	},
 	KeyBindingForGlobalKeyBindingPopUp: []string{
 		"[esc] close",
 	},
@@ -282,6 +470,8 @@ var eN = LanguageMapping{
 	GitDiscardUntrackedConfirmation:                          "Are you sure you want to discard untracked changes for [%s] ?",
 	GitDiscardNewlyAddedorCopyConfirmation:                   "Are you sure you want to discard newly tracked or copied changes for [%s] ? \n * This will remove untracked changes also",
 	GitDiscardAndRevertRenameConfirmation:                    "Are you sure you want to discard changes and revert rename for [%s] ?",
+	GitDiscardPartialConfirmation:                            "Are you sure you want to discard the following change(s) of [%s] ?",
+	GitDiscardDirectoryConfirmation:                          "Are you sure you want to discard all changes under [%s] ? \n * This will remove untracked files within it also",
 	GitStashAllTitle:                                         "Stash All File(s)",
 	GitStashFileTitle:                                        "Stash File",
 	GitStashApplyTitle:                                       "Apply Stash",
@@ -304,9 +494,153 @@ var eN = LanguageMapping{
 	GitResolveConflictResetInfo:                              "Restores conflict markers in: %s (discards staged changes)",
 	GitResolveConflictAcceptLocalChangesInfo:                 "Accept local changes for conflicted file: %s",
 	GitResolveConflictAcceptIncomingChangesInfo:              "Accept incoming changes for conflicted file: %s",
+	GitResolveConflictEditor:                                 "Resolve block by block",
+	GitResolveConflictEditorInfo:                             "Choose ours, theirs, both or base for every conflict block in: %s",
+	GitConflictEditorTitle:                                   "Conflict Editor: %s (%d/%d blocks resolved)",
+	GitConflictEditorNoConflictBlock:                         "No conflict marker was found, press enter to mark the file as resolved",
+	GitConflictEditorNotStagedInfo:                           "The file will only be staged once every block is resolved",
+	GitConflictEditorResolutionOurs:                          "ours",
+	GitConflictEditorResolutionTheirs:                        "theirs",
+	GitConflictEditorResolutionBoth:                          "both",
+	GitConflictEditorResolutionBase:                          "base",
+	GitConflictEditorResolutionNone:                          "unresolved",
+	RepoOperationMerging:                                     "MERGING",
+	RepoOperationRebasing:                                    "REBASING",
+	RepoOperationApplyingMailbox:                             "APPLYING PATCHES",
+	RepoOperationCherryPicking:                               "CHERRY-PICKING",
+	RepoOperationReverting:                                   "REVERTING",
+	RepoOperationBisecting:                                   "BISECTING",
+	GitRepoOperationOptionTitle:                              "%s in progress, choose an action",
+	GitRepoOperationContinue:                                 "Continue",
+	GitRepoOperationSkip:                                     "Skip",
+	GitRepoOperationAbort:                                    "Abort",
+	GitRepoOperationProcessing:                               "Processing...",
+	GitResolveConflictMergeTool:                              "Open in merge tool",
+	GitMergeToolResultTitle:                                  "Merge Tool",
+	GitMergeToolResolved:                                     "The conflict of [%s] was resolved",
+	GitMergeToolUnresolved:                                   "[%s] still has conflict",
+	GitMergeToolFailed:                                       "The merge tool exited with error: %s",
+	GitApplyPatchErrorTitle:                                  "Apply Changes",
+	GitApplyPatchFailed:                                      "The selected changes could not be applied: %s",
+	GitPathspecTitle:                                         "Match Files By Pattern",
+	GitPathspecPlaceholder:                                   "Enter a pathspec or glob, eg, *.pb.go, src/**/*.ts",
+	GitPathspecHint:                                          "The modified files matching the pattern will be previewed here",
+	GitPathspecInvalid:                                       "Invalid pattern",
+	GitPathspecMatchedCount:                                  "%d file(s) matched",
+	GitPathspecActionOptionTitle:                             "Files matching \"%s\"",
+	GitPathspecActionStage:                                   "Stage",
+	GitPathspecActionStageInfo:                               "stage the %d matched file(s)",
+	GitPathspecActionUnstage:                                 "Unstage",
+	GitPathspecActionUnstageInfo:                             "unstage the %d matched file(s), the changes are kept",
+	GitPathspecActionDiscard:                                 "Discard",
+	GitPathspecActionDiscardInfo:                             "discard the changes of the %d matched file(s)",
+	GitPathspecActionStash:                                   "Stash",
+	GitPathspecActionStashInfo:                               "stash the changes of the %d matched file(s)",
+	GitIgnoreRuleOptionTitle:                                 "Ignore \"%s\" by",
+	GitIgnoreRulePath:                                        "This exact path",
+	GitIgnoreRuleExtension:                                   "Every file with this extension",
+	GitIgnoreRuleDirectory:                                   "The directory of this file",
+	GitIgnoreTargetOptionTitle:                               "Write the ignore rule of \"%s\" to",
+	GitIgnoreTargetRoot:                                      "Root .gitignore",
+	GitIgnoreTargetNested:                                    "Nearest .gitignore",
+	GitIgnoreTargetExclude:                                   ".git/info/exclude (only for this clone)",
+	GitIgnoreRulePreview:                                     "append \"%s\" to %s",
+	GitCleanTitle:                                            "Clean Untracked Files",
+	GitCleanOptions:                                          "[d] untracked directories: %s   [x] ignored files: %s",
+	GitCleanOptionOn:                                         "included",
+	GitCleanOptionOff:                                        "left out",
+	GitCleanIgnoredKeep:                                      "kept",
+	GitCleanIgnoredInclude:                                   "removed too (-x)",
+	GitCleanIgnoredOnly:                                      "only these (-X)",
+	GitCleanNothingToClean:                                   "Nothing to clean",
+	GitCleanCheckedCount:                                     "%d of %d checked, only the checked entries will be removed",
+	ModifiedFilesShowingIgnored:                              "with ignored",
+	IgnoredFileMatchingRule:                                  "Ignored by the rule:",
+	IgnoredFileNoMatchingRule:                                "No ignore rule matches this file anymore",
+	IgnoredFileRuleSource:                                    "from %s, line %s",
+	GitRestoreSourceTitle:                                    "Restore %s from",
+	GitRestoreFileTitle:                                      "Restore a file as of %s",
+	GitRestoreTargetOptionTitle:                              "Restore %s as of %s to",
+	GitRestoreSourceBranch:                                   "branch",
+	GitRestoreSourceRemoteBranch:                             "remote",
+	GitRestoreSourceTag:                                      "tag",
+	GitRestoreSourceCommit:                                   "commit",
+	GitRestoreTargetWorktree:                                 "Working tree",
+	GitRestoreTargetWorktreeInfo:                             "Only the working tree is restored, the staged changes are kept",
+	GitRestoreTargetStaged:                                   "Index (staged)",
+	GitRestoreTargetStagedInfo:                               "Only the index is restored, the working tree is kept",
+	GitRestoreTargetBoth:                                     "Index and working tree",
+	GitRestoreTargetBothInfo:                                 "The file will be exactly as of the revision",
+	GitRestoreOverwriteConfirmation:                          "The local changes of %s will be overwritten by its version as of %s (%s), continue?",
+	GitRestoreRemoveConfirmation:                             "%s doesn't exist as of %s, it will be removed along with its local changes (%s), continue?",
+	CommitLogFiltered:                                        "filtered",
+	CommitLogJumpNotFound:                                    "%s is not within this commit log",
+	GitCommitLogFilterTitle:                                  "Filter Commit Log",
+	GitCommitLogFilterMessageTitle:                           "Message (--grep)",
+	GitCommitLogFilterAuthorTitle:                            "Author (--author)",
+	GitCommitLogFilterSinceTitle:                             "Since (--since)",
+	GitCommitLogFilterUntilTitle:                             "Until (--until)",
+	GitCommitLogFilterPathTitle:                              "Path (-- <path>)",
+	GitCommitLogFilterPickaxeTextTitle:                       "Text added or removed (-S)",
+	GitCommitLogFilterPickaxeRegexTitle:                      "Changed lines matching regex (-G)",
+	GitCommitLogFilterRegexPlaceholder:                       "regex, case insensitive",
+	GitCommitLogFilterDatePlaceholder:                        "eg, 2024-01-31 or 2 weeks ago",
+	GitCommitLogFilterPathPlaceholder:                        "a file or directory, eg, src/main.go",
+	GitCommitLogFilterPickaxeConflict:                        "-S and -G can't be used together",
+	GitCommitLogFilterGraphHint:                              "The commit graph is not shown while the commit log is filtered",
+	CommitLogScopeCurrentBranch:                              "current branch",
+	CommitLogScopeCurrentBranchInfo:                          "The history of HEAD",
+	CommitLogScopeAllRefs:                                    "all refs",
+	CommitLogScopeAllRefsInfo:                                "The history of every branch, remote branch and tag",
+	CommitLogScopeSelectedBranches:                           "selected branches",
+	CommitLogScopeSelectedBranchesInfo:                       "The history of the local and remote branches that you check",
+	CommitLogScopeFirstParent:                                "first parent",
+	CommitLogScopeFirstParentInfo:                            "The history of HEAD following only the first parent of merges (--first-parent)",
+	GitCommitLogScopeOptionTitle:                             "Draw the commit log graph for",
+	GitCommitLogScopeBranchesTitle:                           "Draw the commit log graph for the checked branches",
+	GitCommitLogScopeBranchesCheckedCount:                    "%d of %d checked",
+	GitCommitLogScopeNoBranch:                                "There is no branch to choose from",
+	CommitLogFilesTitle:                                      "Files changed in %s",
+	CommitLogFilesAgainstParent:                              "(against parent %d %s)",
+	GitCommitParentOptionTitle:                               "Diff the merge commit %s against",
+	GitCommitParentOptionName:                                "parent %d",
 	GitDeleteBranchTitle:                                     "Delete Branch",
 	GitDeleteBranchComfirmPrompt:                             "Are you sure to delete the following branch \n [%s]",
 	DeletingBranch:                                           "Deleting branch...",
+	GitBatchDiscardFilesConfirmation:                         "Are you sure you want to discard all changes of the following %d file(s) ? \n * This will remove untracked files also",
+	GitBatchStashFilesConfirmation:                           "Are you sure you want to stash the changes of the following %d file(s) ?",
+	GitBatchDeleteBranchesConfirmation:                       "Are you sure you want to delete the following %d branch(es) ?",
+	GitBatchDropStashesConfirmation:                          "Are you sure you want to discard the following %d stash(es) ?",
+	GitBatchOperationMoreItems:                               " ... and %d more",
+	GitBatchOperationTitle:                                   "Batch Operation",
+	GitBatchOperationProcessing:                              "Processing...",
+	GitDiscardSkippedConflictFile:                            "skipped [%s], file is in conflict state",
+	DiffOptionsContextLines:                                  "context: %d",
+	DiffOptionsAlgorithm:                                     "algorithm: %s",
+	DiffOptionsRenamesOn:                                     "renames: on",
+	DiffOptionsRenamesOff:                                    "renames: off",
+	DiffOptionsIgnoreSpaceChange:                             "ignoring whitespace changes",
+	DiffOptionsIgnoreAllSpace:                                "ignoring all whitespace",
+	DiffOptionsReadOnly:                                      "* hunk/line staging and discarding are unavailable with the current diff options",
+	FilePreviewBinaryFile:                                    "Binary file, the content can't be shown as a text diff",
+	FilePreviewBefore:                                        "Before (HEAD)",
+	FilePreviewAfter:                                         "After (working tree)",
+	FilePreviewSize:                                          "Size",
+	FilePreviewSizeChange:                                    "Size change",
+	FilePreviewMimeType:                                      "Type",
+	FilePreviewImageDimensions:                               "Dimensions",
+	FilePreviewNotExist:                                      "(not exist)",
+	FilePreviewLargeFilePage:                                 "Large file (%s), the diff is shown %d lines per page: page %d",
+	FilePreviewLastPage:                                      "(last page)",
+	BlameTitle:                                               "Blame",
+	BlameUnavailable:                                         "The blame is not available for the current selected file (eg, it is untracked or newly added)",
+	BlameNotCommittedYet:                                     "Not committed yet",
+	RelativeTimeJustNow:                                      "just now",
+	RelativeTimeMinutesAgo:                                   "%d minutes ago",
+	RelativeTimeHoursAgo:                                     "%d hours ago",
+	RelativeTimeDaysAgo:                                      "%d days ago",
+	RelativeTimeMonthsAgo:                                    "%d months ago",
+	RelativeTimeYearsAgo:                                     "%d years ago",
 }
 
 // for about gitti
@@ -426,9 +760,114 @@ var enGlobalKeyBinding = []GlobalKeyBindingMappingFormat{
 		TitleOrInfoLine: "navigated between staged and unstaged diff detail component panel",
 		LineType:        INFO,
 	},
+	{
+		KeyBindingLine:  "m",
+		TitleOrInfoLine: "mark or unmark the selected item for batch operation (files, branches and stash)",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "M",
+		TitleOrInfoLine: "clear all the marks of the current list",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "t",
+		TitleOrInfoLine: "toggle the modified files panel between list and directory tree view",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "v",
+		TitleOrInfoLine: "toggle between hunk and line mode in the file diff detail panel",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "|",
+		TitleOrInfoLine: "toggle the diff detail panel between unified and side by side view (old content on the left, new content on the right)",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "w",
+		TitleOrInfoLine: "cycle the diff whitespace option (show all / ignore whitespace changes / ignore all whitespace)",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "{ / }",
+		TitleOrInfoLine: "decrease or increase the number of diff context lines",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "D",
+		TitleOrInfoLine: "cycle the diff algorithm (myers / patience / histogram)",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "R",
+		TitleOrInfoLine: "toggle rename detection of the diff",
+		LineType:        INFO,
+	},
 	{
 		KeyBindingLine:  "- / +",
 		TitleOrInfoLine: "increase or decrease the left panel width ratio [!!]",
 		LineType:        INFO,
 	},
+	{
+		KeyBindingLine:  "< / >",
+		TitleOrInfoLine: "Previous / next page of a large file diff",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "b",
+		TitleOrInfoLine: "Toggle the blame of the selected modified file in detail panel",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "o",
+		TitleOrInfoLine: "Continue, skip or abort the merge, rebase, cherry-pick, revert or bisect in progress",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "T",
+		TitleOrInfoLine: "Open the selected modified file in the merge tool (conflicted file) or diff tool configured in git config",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "g",
+		TitleOrInfoLine: "Stage, unstage, discard or stash the modified files matching a pathspec or glob pattern (eg, *.pb.go, src/**/*.ts)",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "i",
+		TitleOrInfoLine: "Ignore the selected untracked file by its path, extension or directory, written to the root .gitignore, the nearest .gitignore or .git/info/exclude",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "C",
+		TitleOrInfoLine: "Clean untracked files, preview what git clean would remove (with untracked directories and ignored files as options) and remove only the checked entries",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "I",
+		TitleOrInfoLine: "Show or hide the ignored files in the modified files panel, selecting one will show the .gitignore rule that matched it",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "f",
+		TitleOrInfoLine: "Restore the selected modified file as of a branch, tag or recent commit, or a file changed by the selected commit as of the commit (to the working tree, the index or both)",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "/",
+		TitleOrInfoLine: "Filter the commit log by message, author, date range, path or pickaxe (-S/-G), press esc on the commit log panel to clear the filter",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "G",
+		TitleOrInfoLine: "Choose the history the commit log graph is drawn for: the current branch, all refs, selected branches or the first parent only",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "enter",
+		TitleOrInfoLine: "On the commit log, list the files changed by the commit to view the diff of each file (choose the parent for a merge commit), press esc to go back",
+		LineType:        INFO,
+	},
 }
@@ -77,6 +77,8 @@ var jA = LanguageMapping{
 		"[space] この変更をステージ",
 		"[e] 編集",
 		"[r] 競合を解決",
+		"[T] マージツール",
+		"[g] パターン一致",
 		"[enter] 変更内容を表示",
 		"[?] グローバルキー操作",
 	},
@@ -86,6 +88,9 @@ var jA = LanguageMapping{
 		"[s] スタッシュ",
 		"[S] すべての変更をスタッシュ",
 		"[d] 変更を破棄",
+		"[T] 差分ツール",
+		"[f] リビジョンから復元",
+		"[g] パターン一致",
 		"[enter] 変更内容を表示",
 		"[?] グローバルキー操作",
 	},
@@ -95,20 +100,83 @@ var jA = LanguageMapping{
 		"[s] スタッシュ",
 		"[S] すべての変更をスタッシュ",
 		"[d] 変更を破棄",
+		"[i] 無視 (未追跡)",
+		"[T] 差分ツール",
+		"[I] 無視ファイルの表示切替",
+		"[f] リビジョンから復元",
+		"[g] パターン一致",
 		"[enter] 変更内容を表示",
 		"[?] グローバルキー操作",
 	},
 	KeyBindingModifiedFilesComponentNone: []string{
 		"[?] グローバルキー操作",
 	},
+	KeyBindingModifiedFilesComponentDirectory: []string{
+		"[space] ディレクトリをステージ/アンステージ",
+		"[d] ディレクトリの変更を破棄",
+		"[enter] 展開/折りたたみ",
+		"[t] ツリー表示の切り替え",
+		"[?] グローバルキー操作",
+	},
+	KeyBindingModifiedFilesComponentIgnored: []string{
+		"[I] 無視ファイルを非表示",
+		"[t] ツリー表示の切り替え",
+		"[enter] 一致した無視ルールを表示",
+		"[?] グローバルキー操作",
+	},
 	KeyBindingCommitLogComponent: []string{
 		"[↑/↓] 上下に移動",
-		"[enter] コミットログの内容を表示",
+		"[enter] 変更されたファイルを表示",
+		"[f] このコミット時点のファイルを復元",
+		"[/] コミットログをフィルター",
+		"[G] グラフの範囲",
+		"[?] グローバルキー操作",
+	},
+	KeyBindingCommitLogComponentFiltered: []string{
+		"[↑/↓] 上下に移動",
+		"[enter] 変更されたファイルを表示",
+		"[f] このコミット時点のファイルを復元",
+		"[/] フィルターを変更",
+		"[esc] フィルターをクリア",
+		"[G] グラフの範囲",
+		"[?] グローバルキー操作",
+	},
+	KeyBindingCommitLogComponentFilesView: []string{
+		"[↑/↓] 上下に移動",
+		"[enter] ファイルの差分を表示",
+		"[f] このコミット時点のファイルを復元",
+		"[esc] コミットログに戻る",
 		"[?] グローバルキー操作",
 	},
 	KeyBindingKeyDetailComponent: []string{
 		"[←/→] 左右に移動",
 		"[↑/↓] 上下に移動",
+		"[|] 横並び差分の切り替え",
+		"[esc] 戻る",
+		"[?] グローバルキー操作",
+	},
+	KeyBindingKeyDetailComponentFileDiff: []string{
+		"[↑/↓] ハンク/行を移動",
+		"[v] ハンク/行モード切替",
+		"[space] ハンク/行をステージ/アンステージ",
+		"[d] ハンク/行を破棄",
+		"[←/→] 左右に移動",
+		"[|] 横並び差分の切り替え",
+		"[esc] 戻る",
+		"[?] グローバルキー操作",
+	},
+	KeyBindingKeyDetailComponentPagedDiff: []string{
+		"[←/→] 左右に移動",
+		"[↑/↓] 上下に移動",
+		"[<] 前のページ",
+		"[>] 次のページ",
+		"[esc] 戻る",
+		"[?] グローバルキー操作",
+	},
+	KeyBindingKeyDetailComponentBlame: []string{
+		"[↑/↓] 行を移動",
+		"[enter] コミットへ移動",
+		"[b] 差分に戻る",
 		"[esc] 戻る",
 		"[?] グローバルキー操作",
 	},
@@ -123,6 +191,26 @@ var jA = LanguageMapping{
 	KeyBindingKeyStashComponentNone: []string{
 		"[?] グローバルキー操作",
 	},
+	KeyBindingLocalBranchComponentMarked: []string{
+		"[d] マークしたブランチを削除",
+		"[m] マーク/マーク解除",
+		"[M] マークをすべて解除",
+		"[?] グローバルキー操作",
+	},
+	KeyBindingModifiedFilesComponentMarked: []string{
+		"[space] マークしたファイルをステージ/アンステージ",
+		"[s] マークしたファイルをスタッシュ",
+		"[d] マークしたファイルの変更を破棄",
+		"[m] マーク/マーク解除",
+		"[M] マークをすべて解除",
+		"[?] グローバルキー操作",
+	},
+	KeyBindingKeyStashComponentMarked: []string{
+		"[d] マークしたスタッシュを破棄",
+		"[m] マーク/マーク解除",
+		"[M] マークをすべて解除",
+		"[?] グローバルキー操作",
+	},
 	KeyBindingForCommitPopUp: []string{
 		"[tab] 次の入力欄に移動",
 		"[shift+tab] 前の入力欄に移動",
@@ -213,6 +301,106 @@ var jA = LanguageMapping{
 	KeyBindingForCreateBranchBasedOnRemoteOutputPopUp: []string{
 		"[esc] 閉じる",
 	},
+	KeyBindingForGitBatchOperationConfirmPromptPopUp: []string{
+		"[enter] 実行",
+		"[esc] キャンセル / 閉じる",
+	},
+	KeyBindingForGitBatchOperationOutputPopUp: []string{
+		"[esc] 閉じる",
+	},
+	KeyBindingForGitConflictEditorPopUp: []string{
+		"[↑/↓] 前/次のブロック",
+		"[1] ours",
+		"[2] theirs",
+		"[3] 両方",
+		"[4] base",
+		"[backspace] 取り消し",
+		"[enter] 書き戻す",
+		"[esc] キャンセル",
+	},
+	KeyBindingForGitRepoOperationOptionPopUp: []string{
+		"[↑/↓] 上下に移動",
+		"[enter] 操作を選択",
+		"[esc] キャンセル / 閉じる",
+	},
+	KeyBindingForGitRepoOperationOutputPopUp: []string{
+		"[esc] 閉じる",
+	},
+	KeyBindingForGitMergeToolResultPopUp: []string{
+		"[enter/esc] 閉じる",
+	},
+	KeyBindingForGitPathspecPopUp: []string{
+		"[enter] 一致したファイルの操作を選択",
+		"[esc] キャンセル",
+	},
+	KeyBindingForGitPathspecActionOptionPopUp: []string{
+		"[↑/↓] 上下に移動",
+		"[enter] 操作を選択",
+		"[esc] キャンセル / 閉じる",
+	},
+	KeyBindingForGitIgnoreRuleOptionPopUp: []string{
+		"[↑/↓] 上下に移動",
+		"[enter] ルールを選択",
+		"[esc] キャンセル / 閉じる",
+	},
+	KeyBindingForGitIgnoreTargetOptionPopUp: []string{
+		"[↑/↓] 上下に移動",
+		"[enter] ルールを書き込む",
+		"[esc] キャンセル / 閉じる",
+	},
+	KeyBindingForGitCleanPopUp: []string{
+		"[↑/↓] 上下に移動",
+		"[space] チェック / 解除",
+		"[A] すべてチェック / 解除",
+		"[d] ディレクトリ",
+		"[x] 無視ファイル",
+		"[enter] チェックしたものを削除",
+		"[esc] キャンセル",
+	},
+	KeyBindingForGitRestoreSourcePopUp: []string{
+		"[↑/↓] 上下に移動",
+		"[enter] リビジョンを選択",
+		"[esc] キャンセル / 閉じる",
+	},
+	KeyBindingForGitRestoreFilePopUp: []string{
+		"[↑/↓] 上下に移動",
+		"[enter] ファイルを選択",
+		"[esc] キャンセル / 閉じる",
+	},
+	KeyBindingForGitRestoreTargetOptionPopUp: []string{
+		"[↑/↓] 上下に移動",
+		"[enter] 復元",
+		"[esc] キャンセル / 閉じる",
+	},
+	KeyBindingForGitRestoreConfirmPromptPopUp: []string{
+		"[enter] 復元を実行",
+		"[esc] キャンセル / 閉じる",
+	},
+	KeyBindingForGitCommitLogFilterPopUp: []string{
+		"[tab] 次の入力欄へ移動",
+		"[shift+tab] 前の入力欄へ移動",
+		"[enter] フィルターを適用（すべて空でクリア）",
+		"[esc] キャンセル / 閉じる",
+	},
+	KeyBindingForGitCommitLogScopeOptionPopUp: []string{
+		"[↑/↓] 上下に移動",
+		"[enter] 選択",
+		"[esc] キャンセル / 閉じる",
+	},
+	KeyBindingForGitCommitLogScopeBranchesPopUp: []string{
+		"[↑/↓] 上下に移動",
+		"[space] チェック / 解除",
+		"[enter] チェックしたもののグラフを表示",
+		"[esc] キャンセル / 閉じる",
+	},
+	KeyBindingForGitCommitParentOptionPopUp: []string{
+		"[↑/↓] 上下に移動",
+		"[enter] この親と比較",
+		"[esc] キャンセル / 閉じる",
+	},
+	KeyBindingForGitApplyPatchErrorPopUp: []string{
+		"[enter/esc] 閉じる",
+	},
 	KeyBindingForGlobalKeyBindingPopUp: []string{
 		"[esc] 閉じる",
 	},
@@ -282,6 +470,8 @@ var jA = LanguageMapping{
 	GitDiscardUntrackedConfirmation:                          "[%s] の追跡対象外の変更を破棄してもよろしいですか？",
 	GitDiscardNewlyAddedorCopyConfirmation:                   "[%s] の新規追加またはコピーされた変更を破棄してもよろしいですか？ \n * これにより, 追跡対象外の変更も削除されます",
 	GitDiscardAndRevertRenameConfirmation:                    "ファイル [%s] の変更を破棄し、名前変更を取り消してもよろしいですか？",
+	GitDiscardPartialConfirmation:                            "[%s] の以下の変更を破棄してもよろしいですか？",
+	GitDiscardDirectoryConfirmation:                          "[%s] 配下のすべての変更を破棄してもよろしいですか？ \n * 未追跡のファイルも削除されます",
 	GitStashAllTitle:                                         "すべてのファイルをスタッシュ",
 	GitStashFileTitle:                                        "ファイルをスタッシュ",
 	GitStashApplyTitle:                                       "スタッシュを適用",
@@ -304,9 +494,153 @@ var jA = LanguageMapping{
 	GitResolveConflictResetInfo:                              "%s の競合マーカーを復元します（ステージされた変更は破棄されます）",
 	GitResolveConflictAcceptLocalChangesInfo:                 "競合しているファイル %s でローカルの変更を採用します",
 	GitResolveConflictAcceptIncomingChangesInfo:              "競合しているファイル %s で取り込む側の変更を採用します",
+	GitResolveConflictEditor:                                 "ブロックごとに解決",
+	GitResolveConflictEditorInfo:                             "%s の各コンフリクトブロックで ours、theirs、両方、base を選択",
+	GitConflictEditorTitle:                                   "コンフリクトエディタ: %s (%d/%d ブロック解決済み)",
+	GitConflictEditorNoConflictBlock:                         "コンフリクトマーカーが見つかりません。enter で解決済みにします",
+	GitConflictEditorNotStagedInfo:                           "すべてのブロックが解決されたときにのみファイルがステージされます",
+	GitConflictEditorResolutionOurs:                          "ours",
+	GitConflictEditorResolutionTheirs:                        "theirs",
+	GitConflictEditorResolutionBoth:                          "両方",
+	GitConflictEditorResolutionBase:                          "base",
+	GitConflictEditorResolutionNone:                          "未解決",
+	RepoOperationMerging:                                     "マージ中",
+	RepoOperationRebasing:                                    "リベース中",
+	RepoOperationApplyingMailbox:                             "パッチ適用中",
+	RepoOperationCherryPicking:                               "チェリーピック中",
+	RepoOperationReverting:                                   "リバート中",
+	RepoOperationBisecting:                                   "二分探索中",
+	GitRepoOperationOptionTitle:                              "%s です。操作を選択してください",
+	GitRepoOperationContinue:                                 "続行",
+	GitRepoOperationSkip:                                     "スキップ",
+	GitRepoOperationAbort:                                    "中止",
+	GitRepoOperationProcessing:                               "処理中...",
+	GitResolveConflictMergeTool:                              "マージツールで開く",
+	GitMergeToolResultTitle:                                  "マージツール",
+	GitMergeToolResolved:                                     "[%s] のコンフリクトは解決されました",
+	GitMergeToolUnresolved:                                   "[%s] にはまだコンフリクトがあります",
+	GitMergeToolFailed:                                       "マージツールがエラーで終了しました: %s",
+	GitApplyPatchErrorTitle:                                  "変更の適用",
+	GitApplyPatchFailed:                                      "選択した変更を適用できませんでした: %s",
+	GitPathspecTitle:                                         "パターンでファイルを一致",
+	GitPathspecPlaceholder:                                   "パス指定またはグロブを入力（例: *.pb.go, src/**/*.ts）",
+	GitPathspecHint:                                          "パターンに一致する変更ファイルがここに表示されます",
+	GitPathspecInvalid:                                       "無効なパターン",
+	GitPathspecMatchedCount:                                  "%d 個のファイルが一致",
+	GitPathspecActionOptionTitle:                             "\"%s\" に一致するファイル",
+	GitPathspecActionStage:                                   "ステージ",
+	GitPathspecActionStageInfo:                               "一致した %d 個のファイルをステージ",
+	GitPathspecActionUnstage:                                 "アンステージ",
+	GitPathspecActionUnstageInfo:                             "一致した %d 個のファイルをアンステージ（変更は保持）",
+	GitPathspecActionDiscard:                                 "破棄",
+	GitPathspecActionDiscardInfo:                             "一致した %d 個のファイルの変更を破棄",
+	GitPathspecActionStash:                                   "スタッシュ",
+	GitPathspecActionStashInfo:                               "一致した %d 個のファイルの変更をスタッシュ",
+	GitIgnoreRuleOptionTitle:                                 "\"%s\" を無視する方法",
+	GitIgnoreRulePath:                                        "このパスのみ",
+	GitIgnoreRuleExtension:                                   "この拡張子のすべてのファイル",
+	GitIgnoreRuleDirectory:                                   "このファイルのディレクトリ",
+	GitIgnoreTargetOptionTitle:                               "\"%s\" の無視ルールの書き込み先",
+	GitIgnoreTargetRoot:                                      "ルートの .gitignore",
+	GitIgnoreTargetNested:                                    "最も近い .gitignore",
+	GitIgnoreTargetExclude:                                   ".git/info/exclude（このクローンのみ）",
+	GitIgnoreRulePreview:                                     "\"%s\" を %s に追加",
+	GitCleanTitle:                                            "未追跡ファイルのクリーン",
+	GitCleanOptions:                                          "[d] 未追跡ディレクトリ: %s   [x] 無視ファイル: %s",
+	GitCleanOptionOn:                                         "含める",
+	GitCleanOptionOff:                                        "含めない",
+	GitCleanIgnoredKeep:                                      "保持",
+	GitCleanIgnoredInclude:                                   "削除も含める (-x)",
+	GitCleanIgnoredOnly:                                      "これのみ (-X)",
+	GitCleanNothingToClean:                                   "クリーンする対象はありません",
+	GitCleanCheckedCount:                                     "%d / %d 件をチェック済み、チェックした項目のみ削除されます",
+	ModifiedFilesShowingIgnored:                              "無視ファイル表示中",
+	IgnoredFileMatchingRule:                                  "一致した無視ルール:",
+	IgnoredFileNoMatchingRule:                                "このファイルに一致する無視ルールはもうありません",
+	IgnoredFileRuleSource:                                    "%s の %s 行目",
+	GitRestoreSourceTitle:                                    "%s の復元元",
+	GitRestoreFileTitle:                                      "%s 時点のファイルを復元",
+	GitRestoreTargetOptionTitle:                              "%s を %s 時点に復元する対象",
+	GitRestoreSourceBranch:                                   "ブランチ",
+	GitRestoreSourceRemoteBranch:                             "リモート",
+	GitRestoreSourceTag:                                      "タグ",
+	GitRestoreSourceCommit:                                   "コミット",
+	GitRestoreTargetWorktree:                                 "作業ツリー",
+	GitRestoreTargetWorktreeInfo:                             "作業ツリーのみ復元し、ステージ済みの変更は保持",
+	GitRestoreTargetStaged:                                   "インデックス (ステージ)",
+	GitRestoreTargetStagedInfo:                               "インデックスのみ復元し、作業ツリーは保持",
+	GitRestoreTargetBoth:                                     "インデックスと作業ツリー",
+	GitRestoreTargetBothInfo:                                 "ファイルをリビジョン時点と完全に同じにする",
+	GitRestoreOverwriteConfirmation:                          "%s のローカル変更は %s 時点のバージョンで上書きされます (%s)。続行しますか？",
+	GitRestoreRemoveConfirmation:                             "%s は %s 時点に存在しないため、ローカル変更とともに削除されます (%s)。続行しますか？",
+	CommitLogFiltered:                                        "フィルター中",
+	CommitLogJumpNotFound:                                    "%s はこのコミットログにありません",
+	GitCommitLogFilterTitle:                                  "コミットログをフィルター",
+	GitCommitLogFilterMessageTitle:                           "メッセージ (--grep)",
+	GitCommitLogFilterAuthorTitle:                            "作成者 (--author)",
+	GitCommitLogFilterSinceTitle:                             "開始日 (--since)",
+	GitCommitLogFilterUntilTitle:                             "終了日 (--until)",
+	GitCommitLogFilterPathTitle:                              "パス (-- <path>)",
+	GitCommitLogFilterPickaxeTextTitle:                       "追加・削除されたテキスト (-S)",
+	GitCommitLogFilterPickaxeRegexTitle:                      "正規表現に一致する変更行 (-G)",
+	GitCommitLogFilterRegexPlaceholder:                       "正規表現（大文字小文字を区別しない）",
+	GitCommitLogFilterDatePlaceholder:                        "例: 2024-01-31 または 2 weeks ago",
+	GitCommitLogFilterPathPlaceholder:                        "ファイルまたはディレクトリ（例: src/main.go）",
+	GitCommitLogFilterPickaxeConflict:                        "-S と -G は同時に使用できません",
+	GitCommitLogFilterGraphHint:                              "フィルター中はコミットグラフは表示されません",
+	CommitLogScopeCurrentBranch:                              "現在のブランチ",
+	CommitLogScopeCurrentBranchInfo:                          "HEAD の履歴",
+	CommitLogScopeAllRefs:                                    "すべての参照",
+	CommitLogScopeAllRefsInfo:                                "すべてのブランチ、リモートブランチ、タグの履歴",
+	CommitLogScopeSelectedBranches:                           "選択したブランチ",
+	CommitLogScopeSelectedBranchesInfo:                       "チェックしたローカル・リモートブランチの履歴",
+	CommitLogScopeFirstParent:                                "最初の親のみ",
+	CommitLogScopeFirstParentInfo:                            "マージの最初の親のみを辿った HEAD の履歴 (--first-parent)",
+	GitCommitLogScopeOptionTitle:                             "コミットロググラフの範囲",
+	GitCommitLogScopeBranchesTitle:                           "チェックしたブランチのコミットロググラフを表示",
+	GitCommitLogScopeBranchesCheckedCount:                    "%d / %d 件をチェック済み",
+	GitCommitLogScopeNoBranch:                                "選択できるブランチはありません",
+	CommitLogFilesTitle:                                      "%s で変更されたファイル",
+	CommitLogFilesAgainstParent:                              "(親 %d %s と比較)",
+	GitCommitParentOptionTitle:                               "マージコミット %s の比較対象",
+	GitCommitParentOptionName:                                "親 %d",
 	GitDeleteBranchTitle:                                     "ブランチを削除",
 	GitDeleteBranchComfirmPrompt:                             "以下のブランチを削除してもよろしいですか \n [%s]",
 	DeletingBranch:                                           "ブランチを削除中...",
+	GitBatchDiscardFilesConfirmation:                         "以下の %d 個のファイルのすべての変更を破棄してもよろしいですか？ \n * 未追跡のファイルも削除されます",
+	GitBatchStashFilesConfirmation:                           "以下の %d 個のファイルの変更をスタッシュしてもよろしいですか？",
+	GitBatchDeleteBranchesConfirmation:                       "以下の %d 個のブランチを削除してもよろしいですか？",
+	GitBatchDropStashesConfirmation:                          "以下の %d 個のスタッシュを破棄してもよろしいですか？",
+	GitBatchOperationMoreItems:                               " ... 他 %d 件",
+	GitBatchOperationTitle:                                   "一括操作",
+	GitBatchOperationProcessing:                              "処理中...",
+	GitDiscardSkippedConflictFile:                            "[%s] はコンフリクト状態のためスキップしました",
+	DiffOptionsContextLines:                                  "コンテキスト: %d",
+	DiffOptionsAlgorithm:                                     "アルゴリズム: %s",
+	DiffOptionsRenamesOn:                                     "リネーム検出: オン",
+	DiffOptionsRenamesOff:                                    "リネーム検出: オフ",
+	DiffOptionsIgnoreSpaceChange:                             "空白の変更を無視",
+	DiffOptionsIgnoreAllSpace:                                "すべての空白を無視",
+	DiffOptionsReadOnly:                                      "* 現在の差分オプションではハンク/行のステージと破棄は利用できません",
+	FilePreviewBinaryFile:                                    "バイナリファイルのため、テキスト差分として表示できません",
+	FilePreviewBefore:                                        "変更前 (HEAD)",
+	FilePreviewAfter:                                         "変更後 (作業ツリー)",
+	FilePreviewSize:                                          "サイズ",
+	FilePreviewSizeChange:                                    "サイズ変化",
+	FilePreviewMimeType:                                      "種類",
+	FilePreviewImageDimensions:                               "画像サイズ",
+	FilePreviewNotExist:                                      "(存在しません)",
+	FilePreviewLargeFilePage:                                 "大きなファイル (%s) のため、差分を 1 ページ %d 行で表示しています: %d ページ目",
+	FilePreviewLastPage:                                      "(最終ページ)",
+	BlameTitle:                                               "Blame (行ごとの最終変更)",
+	BlameUnavailable:                                         "現在選択されているファイルの blame は利用できません (例: 未追跡または新規追加のファイル)",
+	BlameNotCommittedYet:                                     "未コミット",
+	RelativeTimeJustNow:                                      "たった今",
+	RelativeTimeMinutesAgo:                                   "%d 分前",
+	RelativeTimeHoursAgo:                                     "%d 時間前",
+	RelativeTimeDaysAgo:                                      "%d 日前",
+	RelativeTimeMonthsAgo:                                    "%d か月前",
+	RelativeTimeYearsAgo:                                     "%d 年前",
 }
 
 // for about gitti
@@ -433,9 +767,114 @@ var jaGlobalKeyBinding = []GlobalKeyBindingMappingFormat{
 		TitleOrInfoLine: "ステージ済みおよびステージなしの差分詳細パネル間を移動",
 		LineType:        INFO,
 	},
+	{
+		KeyBindingLine:  "m",
+		TitleOrInfoLine: "一括操作のために選択中の項目をマーク/マーク解除 (ファイル、ブランチ、スタッシュ)",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "M",
+		TitleOrInfoLine: "現在のリストのマークをすべて解除",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "t",
+		TitleOrInfoLine: "変更ファイルパネルをリスト表示とディレクトリツリー表示で切り替え",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "v",
+		TitleOrInfoLine: "ファイル差分詳細パネルでハンク/行モードを切り替え",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "|",
+		TitleOrInfoLine: "差分詳細パネルを統合表示と横並び表示で切り替える（左が変更前、右が変更後）",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "w",
+		TitleOrInfoLine: "差分の空白オプションを切り替える（すべて表示 / 空白の変更を無視 / すべての空白を無視）",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "{ / }",
+		TitleOrInfoLine: "差分のコンテキスト行数を減らす / 増やす",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "D",
+		TitleOrInfoLine: "差分アルゴリズムを切り替える（myers / patience / histogram）",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "R",
+		TitleOrInfoLine: "差分のリネーム検出を切り替える",
+		LineType:        INFO,
+	},
 	{
 		KeyBindingLine:  "- / +",
 		TitleOrInfoLine: "左パネルの幅の比率を増減 [!!]",
 		LineType:        INFO,
 	},
+	{
+		KeyBindingLine:  "< / >",
+		TitleOrInfoLine: "大きなファイルの差分の前 / 次のページ",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "b",
+		TitleOrInfoLine: "選択中の変更ファイルの blame を詳細パネルで切り替え",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "o",
+		TitleOrInfoLine: "進行中のマージ、リベース、チェリーピック、リバート、二分探索を続行、スキップ、中止する",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "T",
+		TitleOrInfoLine: "選択した変更ファイルを git config で設定されたマージツール（コンフリクトファイル）または差分ツールで開く",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "g",
+		TitleOrInfoLine: "パス指定またはグロブパターン（例: *.pb.go, src/**/*.ts）に一致する変更ファイルをステージ、アンステージ、破棄、またはスタッシュ",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "i",
+		TitleOrInfoLine: "選択した未追跡ファイルをパス、拡張子、またはディレクトリで無視（ルート .gitignore、最も近い .gitignore、または .git/info/exclude に書き込み）",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "C",
+		TitleOrInfoLine: "未追跡ファイルのクリーン: git clean で削除される対象をプレビューし（未追跡ディレクトリと無視ファイルはオプション）、チェックした項目のみ削除",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "I",
+		TitleOrInfoLine: "変更ファイルパネルで無視ファイルの表示を切り替え、選択すると一致した .gitignore ルールを表示",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "f",
+		TitleOrInfoLine: "選択した変更ファイルをブランチ・タグ・最近のコミット時点に、または選択したコミットで変更されたファイルをそのコミット時点に復元（作業ツリー・インデックス・両方）",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "/",
+		TitleOrInfoLine: "メッセージ・作成者・日付範囲・パス・pickaxe (-S/-G) でコミットログをフィルター、コミットログパネルで esc を押すとフィルターをクリア",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "G",
+		TitleOrInfoLine: "コミットロググラフの範囲を選択：現在のブランチ、すべての参照、選択したブランチ、または最初の親のみ",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "enter",
+		TitleOrInfoLine: "コミットログでコミットの変更ファイルを一覧表示し、各ファイルの差分を表示（マージコミットは比較する親を選択）、esc で戻る",
+		LineType:        INFO,
+	},
 }
@@ -87,10 +87,20 @@ type LanguageMapping struct {
 	KeyBindingModifiedFilesComponentIsStaged          []string
 	KeyBindingModifiedFilesComponentDefault           []string
 	KeyBindingModifiedFilesComponentNone              []string
+	KeyBindingModifiedFilesComponentDirectory         []string
+	KeyBindingModifiedFilesComponentIgnored           []string
 	KeyBindingCommitLogComponent                      []string
+	KeyBindingCommitLogComponentFiltered              []string
+	KeyBindingCommitLogComponentFilesView             []string
 	KeyBindingKeyDetailComponent                      []string
+	KeyBindingKeyDetailComponentFileDiff              []string
+	KeyBindingKeyDetailComponentPagedDiff             []string
+	KeyBindingKeyDetailComponentBlame                 []string
 	KeyBindingKeyStashComponent                       []string
 	KeyBindingKeyStashComponentNone                   []string
+	KeyBindingLocalBranchComponentMarked              []string
+	KeyBindingModifiedFilesComponentMarked            []string
+	KeyBindingKeyStashComponentMarked                 []string
 	KeyBindingForCommitPopUp                          []string
 	KeyBindingForAmendCommitPopUp                     []string
 	KeyBindingForAddRemotePromptPopUp                 []string
@@ -112,6 +122,26 @@ type LanguageMapping struct {
 	KeyBindingForGitDeleteBranchConfirmPromptPopUp    []string
 	KeyBindingForCreateBranchBasedOnRemotePopUp       []string
 	KeyBindingForCreateBranchBasedOnRemoteOutputPopUp []string
+	KeyBindingForGitBatchOperationConfirmPromptPopUp  []string
+	KeyBindingForGitBatchOperationOutputPopUp         []string
+	KeyBindingForGitConflictEditorPopUp               []string
+	KeyBindingForGitRepoOperationOptionPopUp          []string
+	KeyBindingForGitRepoOperationOutputPopUp          []string
+	KeyBindingForGitMergeToolResultPopUp              []string
+	KeyBindingForGitPathspecPopUp                     []string
+	KeyBindingForGitPathspecActionOptionPopUp         []string
+	KeyBindingForGitIgnoreRuleOptionPopUp             []string
+	KeyBindingForGitIgnoreTargetOptionPopUp           []string
+	KeyBindingForGitCleanPopUp                        []string
+	KeyBindingForGitRestoreSourcePopUp                []string
+	KeyBindingForGitRestoreFilePopUp                  []string
+	KeyBindingForGitRestoreTargetOptionPopUp          []string
+	KeyBindingForGitRestoreConfirmPromptPopUp         []string
+	KeyBindingForGitCommitLogFilterPopUp              []string
+	KeyBindingForGitCommitLogScopeOptionPopUp         []string
+	KeyBindingForGitCommitLogScopeBranchesPopUp       []string
+	KeyBindingForGitCommitParentOptionPopUp           []string
+	KeyBindingForGitApplyPatchErrorPopUp              []string
 	KeyBindingForGlobalKeyBindingPopUp                []string
 	// -----------------
 	//  For Pop Up
@@ -198,6 +228,8 @@ type LanguageMapping struct {
 	GitDiscardUntrackedConfirmation        string
 	GitDiscardNewlyAddedorCopyConfirmation string
 	GitDiscardAndRevertRenameConfirmation  string
+	GitDiscardPartialConfirmation          string
+	GitDiscardDirectoryConfirmation        string
 	// for stash operation title (used in output pop up)
 	GitStashAllTitle   string
 	GitStashFileTitle  string
@@ -224,8 +256,156 @@ type LanguageMapping struct {
 	GitResolveConflictResetInfo                 string
 	GitResolveConflictAcceptLocalChangesInfo    string
 	GitResolveConflictAcceptIncomingChangesInfo string
+	GitResolveConflictEditor                    string
+	GitResolveConflictEditorInfo                string
+	GitConflictEditorTitle                      string
+	GitConflictEditorNoConflictBlock            string
+	GitConflictEditorNotStagedInfo              string
+	GitConflictEditorResolutionOurs             string
+	GitConflictEditorResolutionTheirs           string
+	GitConflictEditorResolutionBoth             string
+	GitConflictEditorResolutionBase             string
+	GitConflictEditorResolutionNone             string
+	RepoOperationMerging                        string
+	RepoOperationRebasing                       string
+	RepoOperationApplyingMailbox                string
+	RepoOperationCherryPicking                  string
+	RepoOperationReverting                      string
+	RepoOperationBisecting                      string
+	GitRepoOperationOptionTitle                 string
+	GitRepoOperationContinue                    string
+	GitRepoOperationSkip                        string
+	GitRepoOperationAbort                       string
+	GitRepoOperationProcessing                  string
+	GitResolveConflictMergeTool                 string
+	GitMergeToolResultTitle                     string
+	GitMergeToolResolved                        string
+	GitMergeToolUnresolved                      string
+	GitMergeToolFailed                          string
+	GitApplyPatchErrorTitle                     string
+	GitApplyPatchFailed                         string
+	GitPathspecTitle                            string
+	GitPathspecPlaceholder                      string
+	GitPathspecHint                             string
+	GitPathspecInvalid                          string
+	GitPathspecMatchedCount                     string
+	GitPathspecActionOptionTitle                string
+	GitPathspecActionStage                      string
+	GitPathspecActionStageInfo                  string
+	GitPathspecActionUnstage                    string
+	GitPathspecActionUnstageInfo                string
+	GitPathspecActionDiscard                    string
+	GitPathspecActionDiscardInfo                string
+	GitPathspecActionStash                      string
+	GitPathspecActionStashInfo                  string
+	GitIgnoreRuleOptionTitle                    string
+	GitIgnoreRulePath                           string
+	GitIgnoreRuleExtension                      string
+	GitIgnoreRuleDirectory                      string
+	GitIgnoreTargetOptionTitle                  string
+	GitIgnoreTargetRoot                         string
+	GitIgnoreTargetNested                       string
+	GitIgnoreTargetExclude                      string
+	GitIgnoreRulePreview                        string
+	GitCleanTitle                               string
+	GitCleanOptions                             string
+	GitCleanOptionOn                            string
+	GitCleanOptionOff                           string
+	GitCleanIgnoredKeep                         string
+	GitCleanIgnoredInclude                      string
+	GitCleanIgnoredOnly                         string
+	GitCleanNothingToClean                      string
+	GitCleanCheckedCount                        string
+	ModifiedFilesShowingIgnored                 string
+	IgnoredFileMatchingRule                     string
+	IgnoredFileNoMatchingRule                   string
+	IgnoredFileRuleSource                       string
+	GitRestoreSourceTitle                       string
+	GitRestoreFileTitle                         string
+	GitRestoreTargetOptionTitle                 string
+	GitRestoreSourceBranch                      string
+	GitRestoreSourceRemoteBranch                string
+	GitRestoreSourceTag                         string
+	GitRestoreSourceCommit                      string
+	GitRestoreTargetWorktree                    string
+	GitRestoreTargetWorktreeInfo                string
+	GitRestoreTargetStaged                      string
+	GitRestoreTargetStagedInfo                  string
+	GitRestoreTargetBoth                        string
+	GitRestoreTargetBothInfo                    string
+	GitRestoreOverwriteConfirmation             string
+	GitRestoreRemoveConfirmation                string
+	CommitLogFiltered                           string
+	CommitLogJumpNotFound                       string
+	GitCommitLogFilterTitle                     string
+	GitCommitLogFilterMessageTitle              string
+	GitCommitLogFilterAuthorTitle               string
+	GitCommitLogFilterSinceTitle                string
+	GitCommitLogFilterUntilTitle                string
+	GitCommitLogFilterPathTitle                 string
+	GitCommitLogFilterPickaxeTextTitle          string
+	GitCommitLogFilterPickaxeRegexTitle         string
+	GitCommitLogFilterRegexPlaceholder          string
+	GitCommitLogFilterDatePlaceholder           string
+	GitCommitLogFilterPathPlaceholder           string
+	GitCommitLogFilterPickaxeConflict           string
+	GitCommitLogFilterGraphHint                 string
+	CommitLogScopeCurrentBranch                 string
+	CommitLogScopeCurrentBranchInfo             string
+	CommitLogScopeAllRefs                       string
+	CommitLogScopeAllRefsInfo                   string
+	CommitLogScopeSelectedBranches              string
+	CommitLogScopeSelectedBranchesInfo          string
+	CommitLogScopeFirstParent                   string
+	CommitLogScopeFirstParentInfo               string
+	GitCommitLogScopeOptionTitle                string
+	GitCommitLogScopeBranchesTitle              string
+	GitCommitLogScopeBranchesCheckedCount       string
+	GitCommitLogScopeNoBranch                   string
+	CommitLogFilesTitle                         string
+	CommitLogFilesAgainstParent                 string
+	GitCommitParentOptionTitle                  string
+	GitCommitParentOptionName                   string
 	// for git delete branch
 	GitDeleteBranchTitle         string
 	GitDeleteBranchComfirmPrompt string
 	DeletingBranch               string
+	// for batch operation
+	GitBatchDiscardFilesConfirmation   string
+	GitBatchStashFilesConfirmation     string
+	GitBatchDeleteBranchesConfirmation string
+	GitBatchDropStashesConfirmation    string
+	GitBatchOperationMoreItems         string
+	GitBatchOperationTitle             string
+	GitBatchOperationProcessing        string
+	GitDiscardSkippedConflictFile      string
+	// for diff options
+	DiffOptionsContextLines      string
+	DiffOptionsAlgorithm         string
+	DiffOptionsRenamesOn         string
+	DiffOptionsRenamesOff        string
+	DiffOptionsIgnoreSpaceChange string
+	DiffOptionsIgnoreAllSpace    string
+	DiffOptionsReadOnly          string
+	// for file preview
+	FilePreviewBinaryFile      string
+	FilePreviewBefore          string
+	FilePreviewAfter           string
+	FilePreviewSize            string
+	FilePreviewSizeChange      string
+	FilePreviewMimeType        string
+	FilePreviewImageDimensions string
+	FilePreviewNotExist        string
+	FilePreviewLargeFilePage   string
+	FilePreviewLastPage        string
+	// for blame
+	BlameTitle             string
+	BlameUnavailable       string
+	BlameNotCommittedYet   string
+	RelativeTimeJustNow    string
+	RelativeTimeMinutesAgo string
+	RelativeTimeHoursAgo   string
+	RelativeTimeDaysAgo    string
+	RelativeTimeMonthsAgo  string
+	RelativeTimeYearsAgo   string
 }
@@ -77,6 +77,8 @@ var zH_HANS = LanguageMapping{
 		"[space] 暂存此更改",
 		"[e] 编辑",
 		"[r] 解决冲突",
+		"[T] 合并工具",
+		"[g] 模式匹配",
 		"[enter] 查看修改内容",
 		"[?] 全局快捷键",
 	},
@@ -86,6 +88,9 @@ var zH_HANS = LanguageMapping{
 		"[s] 储藏 (stash)",
 		"[S] 储藏所有更改",
 		"[d] 舍弃更改",
+		"[T] 差异工具",
+		"[f] 从版本恢复",
+		"[g] 模式匹配",
 		"[enter] 查看修改内容",
 		"[?] 全局快捷键",
 	},
@@ -95,20 +100,83 @@ var zH_HANS = LanguageMapping{
 		"[s] 储藏 (stash)",
 		"[S] 储藏所有更改",
 		"[d] 舍弃更改",
+		"[i] 忽略 (未跟踪)",
+		"[T] 差异工具",
+		"[I] 显示/隐藏忽略的文件",
+		"[f] 从版本恢复",
+		"[g] 模式匹配",
 		"[enter] 查看修改内容",
 		"[?] 全局快捷键",
 	},
 	KeyBindingModifiedFilesComponentNone: []string{
 		"[?] 全局快捷键",
 	},
+	KeyBindingModifiedFilesComponentDirectory: []string{
+		"[space] 暂存/取消暂存目录",
+		"[d] 放弃目录更改",
+		"[enter] 展开/折叠",
+		"[t] 切换树状视图",
+		"[?] 全局快捷键",
+	},
+	KeyBindingModifiedFilesComponentIgnored: []string{
+		"[I] 隐藏忽略的文件",
+		"[t] 切换树状视图",
+		"[enter] 查看匹配的忽略规则",
+		"[?] 全局快捷键",
+	},
 	KeyBindingCommitLogComponent: []string{
 		"[↑/↓] 上下移动",
-		"[enter] 查看提交日志内容",
+		"[enter] 查看变更的文件",
+		"[f] 恢复此提交时的文件",
+		"[/] 筛选提交日志",
+		"[G] 提交图范围",
+		"[?] 全局快捷键",
+	},
+	KeyBindingCommitLogComponentFiltered: []string{
+		"[↑/↓] 上下移动",
+		"[enter] 查看变更的文件",
+		"[f] 恢复此提交时的文件",
+		"[/] 修改筛选",
+		"[esc] 清除筛选",
+		"[G] 提交图范围",
+		"[?] 全局快捷键",
+	},
+	KeyBindingCommitLogComponentFilesView: []string{
+		"[↑/↓] 上下移动",
+		"[enter] 查看文件差异",
+		"[f] 恢复此提交时的文件",
+		"[esc] 返回提交日志",
 		"[?] 全局快捷键",
 	},
 	KeyBindingKeyDetailComponent: []string{
 		"[←/→] 左右移动",
 		"[↑/↓] 上下移动",
+		"[|] 切换并排差异视图",
+		"[esc] 返回",
+		"[?] 全局快捷键",
+	},
+	KeyBindingKeyDetailComponentFileDiff: []string{
+		"[↑/↓] 在区块/行之间移动",
+		"[v] 切换区块/行模式",
+		"[space] 暂存/取消暂存区块/行",
+		"[d] 放弃区块/行",
+		"[←/→] 左右移动",
+		"[|] 切换并排差异视图",
+		"[esc] 返回",
+		"[?] 全局快捷键",
+	},
+	KeyBindingKeyDetailComponentPagedDiff: []string{
+		"[←/→] 左右移动",
+		"[↑/↓] 上下移动",
+		"[<] 上一页",
+		"[>] 下一页",
+		"[esc] 返回",
+		"[?] 全局快捷键",
+	},
+	KeyBindingKeyDetailComponentBlame: []string{
+		"[↑/↓] 在行之间移动",
+		"[enter] 跳转到提交",
+		"[b] 返回差异",
 		"[esc] 返回",
 		"[?] 全局快捷键",
 	},
@@ -123,6 +191,26 @@ var zH_HANS = LanguageMapping{
 	KeyBindingKeyStashComponentNone: []string{
 		"[?] 全局按键绑定",
 	},
+	KeyBindingLocalBranchComponentMarked: []string{
+		"[d] 删除已标记的分支",
+		"[m] 标记/取消标记",
+		"[M] 清除所有标记",
+		"[?] 全局快捷键",
+	},
+	KeyBindingModifiedFilesComponentMarked: []string{
+		"[space] 暂存/取消暂存已标记的文件",
+		"[s] 储藏已标记的文件",
+		"[d] 放弃已标记文件的更改",
+		"[m] 标记/取消标记",
+		"[M] 清除所有标记",
+		"[?] 全局快捷键",
+	},
+	KeyBindingKeyStashComponentMarked: []string{
+		"[d] 丢弃已标记的储藏",
+		"[m] 标记/取消标记",
+		"[M] 清除所有标记",
+		"[?] 全局快捷键",
+	},
 	KeyBindingForCommitPopUp: []string{
 		"[tab] 移动到下一个输入框",
 		"[shift+tab] 移动到上一个输入框",
@@ -213,6 +301,106 @@ var zH_HANS = LanguageMapping{
 	KeyBindingForCreateBranchBasedOnRemoteOutputPopUp: []string{
 		"[esc] 关闭",
 	},
+	KeyBindingForGitBatchOperationConfirmPromptPopUp: []string{
+		"[enter] 继续",
+		"[esc] 取消 / 关闭",
+	},
+	KeyBindingForGitBatchOperationOutputPopUp: []string{
+		"[esc] 关闭",
+	},
+	KeyBindingForGitConflictEditorPopUp: []string{
+		"[↑/↓] 上一个/下一个区块",
+		"[1] ours",
+		"[2] theirs",
+		"[3] 两者",
+		"[4] base",
+		"[backspace] 撤销",
+		"[enter] 写回",
+		"[esc] 取消",
+	},
+	KeyBindingForGitRepoOperationOptionPopUp: []string{
+		"[↑/↓] 上下移动",
+		"[enter] 选择操作",
+		"[esc] 取消 / 关闭",
+	},
+	KeyBindingForGitRepoOperationOutputPopUp: []string{
+		"[esc] 关闭",
+	},
+	KeyBindingForGitMergeToolResultPopUp: []string{
+		"[enter/esc] 关闭",
+	},
+	KeyBindingForGitPathspecPopUp: []string{
+		"[enter] 选择对匹配文件的操作",
+		"[esc] 取消",
+	},
+	KeyBindingForGitPathspecActionOptionPopUp: []string{
+		"[↑/↓] 上下移动",
+		"[enter] 选择操作",
+		"[esc] 取消 / 关闭",
+	},
+	KeyBindingForGitIgnoreRuleOptionPopUp: []string{
+		"[↑/↓] 上下移动",
+		"[enter] 选择规则",
+		"[esc] 取消 / 关闭",
+	},
+	KeyBindingForGitIgnoreTargetOptionPopUp: []string{
+		"[↑/↓] 上下移动",
+		"[enter] 写入规则",
+		"[esc] 取消 / 关闭",
+	},
+	KeyBindingForGitCleanPopUp: []string{
+		"[↑/↓] 上下移动",
+		"[space] 勾选 / 取消",
+		"[A] 全部勾选 / 取消",
+		"[d] 目录",
+		"[x] 忽略的文件",
+		"[enter] 删除已勾选",
+		"[esc] 取消",
+	},
+	KeyBindingForGitRestoreSourcePopUp: []string{
+		"[↑/↓] 上下移动",
+		"[enter] 选择版本",
+		"[esc] 取消 / 关闭",
+	},
+	KeyBindingForGitRestoreFilePopUp: []string{
+		"[↑/↓] 上下移动",
+		"[enter] 选择文件",
+		"[esc] 取消 / 关闭",
+	},
+	KeyBindingForGitRestoreTargetOptionPopUp: []string{
+		"[↑/↓] 上下移动",
+		"[enter] 恢复",
+		"[esc] 取消 / 关闭",
+	},
+	KeyBindingForGitRestoreConfirmPromptPopUp: []string{
+		"[enter] 继续恢复",
+		"[esc] 取消 / 关闭",
+	},
+	KeyBindingForGitCommitLogFilterPopUp: []string{
+		"[tab] 移动到下一个输入框",
+		"[shift+tab] 移动到上一个输入框",
+		"[enter] 应用筛选（全部留空则清除）",
+		"[esc] 取消 / 关闭",
+	},
+	KeyBindingForGitCommitLogScopeOptionPopUp: []string{
+		"[↑/↓] 上下移动",
+		"[enter] 选择",
+		"[esc] 取消 / 关闭",
+	},
+	KeyBindingForGitCommitLogScopeBranchesPopUp: []string{
+		"[↑/↓] 上下移动",
+		"[space] 勾选 / 取消",
+		"[enter] 显示已勾选的提交图",
+		"[esc] 取消 / 关闭",
+	},
+	KeyBindingForGitCommitParentOptionPopUp: []string{
+		"[↑/↓] 上下移动",
+		"[enter] 与此父提交比较",
+		"[esc] 取消 / 关闭",
+	},
+	KeyBindingForGitApplyPatchErrorPopUp: []string{
+		"[enter/esc] 关闭",
+	},
 	KeyBindingForGlobalKeyBindingPopUp: []string{
 		"[esc] 关闭",
 	},
@@ -282,6 +470,8 @@ var zH_HANS = LanguageMapping{
 	GitDiscardUntrackedConfirmation:                          "确定要丢弃 [%s] 的未跟踪更改吗？",
 	GitDiscardNewlyAddedorCopyConfirmation:                   "确定要丢弃 [%s] 的新跟踪或复制更改吗？ \n * 这也将移除未跟踪的更改",
 	GitDiscardAndRevertRenameConfirmation:                    "您确定要放弃 [%s] 的更改并撤销重命名吗？",
+	GitDiscardPartialConfirmation:                            "确定要放弃 [%s] 的以下更改吗？",
+	GitDiscardDirectoryConfirmation:                          "确定要放弃 [%s] 下的所有更改吗？ \n * 这也会删除其中未跟踪的文件",
 	GitStashAllTitle:                                         "储藏所有文件",
 	GitStashFileTitle:                                        "储藏文件",
 	GitStashApplyTitle:                                       "应用储藏",
@@ -304,9 +494,153 @@ var zH_HANS = LanguageMapping{
 	GitResolveConflictResetInfo:                              "恢复 %s 中的冲突标记（将丢弃已暂存的更改）",
 	GitResolveConflictAcceptLocalChangesInfo:                 "接受冲突文件 %s 的本地更改",
 	GitResolveConflictAcceptIncomingChangesInfo:              "接受冲突文件 %s 的传入更改",
+	GitResolveConflictEditor:                                 "逐个区块解决",
+	GitResolveConflictEditorInfo:                             "为 %s 中的每个冲突区块选择 ours、theirs、两者或 base",
+	GitConflictEditorTitle:                                   "冲突编辑器：%s (已解决 %d/%d 个区块)",
+	GitConflictEditorNoConflictBlock:                         "未找到冲突标记，按 enter 将文件标记为已解决",
+	GitConflictEditorNotStagedInfo:                           "只有在所有区块都解决后才会暂存文件",
+	GitConflictEditorResolutionOurs:                          "ours",
+	GitConflictEditorResolutionTheirs:                        "theirs",
+	GitConflictEditorResolutionBoth:                          "两者",
+	GitConflictEditorResolutionBase:                          "base",
+	GitConflictEditorResolutionNone:                          "未解决",
+	RepoOperationMerging:                                     "合并中",
+	RepoOperationRebasing:                                    "变基中",
+	RepoOperationApplyingMailbox:                             "应用补丁中",
+	RepoOperationCherryPicking:                               "拣选中",
+	RepoOperationReverting:                                   "还原中",
+	RepoOperationBisecting:                                   "二分查找中",
+	GitRepoOperationOptionTitle:                              "%s，请选择操作",
+	GitRepoOperationContinue:                                 "继续",
+	GitRepoOperationSkip:                                     "跳过",
+	GitRepoOperationAbort:                                    "中止",
+	GitRepoOperationProcessing:                               "处理中...",
+	GitResolveConflictMergeTool:                              "在合并工具中打开",
+	GitMergeToolResultTitle:                                  "合并工具",
+	GitMergeToolResolved:                                     "[%s] 的冲突已解决",
+	GitMergeToolUnresolved:                                   "[%s] 仍有冲突",
+	GitMergeToolFailed:                                       "合并工具出错退出：%s",
+	GitApplyPatchErrorTitle:                                  "应用更改",
+	GitApplyPatchFailed:                                      "无法应用所选更改：%s",
+	GitPathspecTitle:                                         "按模式匹配文件",
+	GitPathspecPlaceholder:                                   "输入路径规格或通配模式，例如 *.pb.go、src/**/*.ts",
+	GitPathspecHint:                                          "匹配模式的修改文件将在此预览",
+	GitPathspecInvalid:                                       "无效的模式",
+	GitPathspecMatchedCount:                                  "匹配到 %d 个文件",
+	GitPathspecActionOptionTitle:                             "匹配 \"%s\" 的文件",
+	GitPathspecActionStage:                                   "暂存",
+	GitPathspecActionStageInfo:                               "暂存匹配的 %d 个文件",
+	GitPathspecActionUnstage:                                 "取消暂存",
+	GitPathspecActionUnstageInfo:                             "取消暂存匹配的 %d 个文件，保留修改",
+	GitPathspecActionDiscard:                                 "丢弃",
+	GitPathspecActionDiscardInfo:                             "丢弃匹配的 %d 个文件的修改",
+	GitPathspecActionStash:                                   "储藏",
+	GitPathspecActionStashInfo:                               "储藏匹配的 %d 个文件的修改",
+	GitIgnoreRuleOptionTitle:                                 "忽略 \"%s\" 的方式",
+	GitIgnoreRulePath:                                        "仅此路径",
+	GitIgnoreRuleExtension:                                   "所有此扩展名的文件",
+	GitIgnoreRuleDirectory:                                   "此文件所在的目录",
+	GitIgnoreTargetOptionTitle:                               "将 \"%s\" 的忽略规则写入",
+	GitIgnoreTargetRoot:                                      "根目录 .gitignore",
+	GitIgnoreTargetNested:                                    "最近的 .gitignore",
+	GitIgnoreTargetExclude:                                   ".git/info/exclude（仅限此克隆）",
+	GitIgnoreRulePreview:                                     "将 \"%s\" 追加到 %s",
+	GitCleanTitle:                                            "清理未跟踪文件",
+	GitCleanOptions:                                          "[d] 未跟踪目录：%s   [x] 忽略的文件：%s",
+	GitCleanOptionOn:                                         "包含",
+	GitCleanOptionOff:                                        "不包含",
+	GitCleanIgnoredKeep:                                      "保留",
+	GitCleanIgnoredInclude:                                   "一并删除 (-x)",
+	GitCleanIgnoredOnly:                                      "仅删除这些 (-X)",
+	GitCleanNothingToClean:                                   "没有需要清理的内容",
+	GitCleanCheckedCount:                                     "已勾选 %d / %d 项，仅删除已勾选的条目",
+	ModifiedFilesShowingIgnored:                              "含忽略的文件",
+	IgnoredFileMatchingRule:                                  "匹配的忽略规则：",
+	IgnoredFileNoMatchingRule:                                "已没有忽略规则匹配此文件",
+	IgnoredFileRuleSource:                                    "来自 %s 第 %s 行",
+	GitRestoreSourceTitle:                                    "恢复 %s 自",
+	GitRestoreFileTitle:                                      "恢复 %s 时的文件",
+	GitRestoreTargetOptionTitle:                              "将 %s 恢复到 %s 时的版本至",
+	GitRestoreSourceBranch:                                   "分支",
+	GitRestoreSourceRemoteBranch:                             "远程",
+	GitRestoreSourceTag:                                      "标签",
+	GitRestoreSourceCommit:                                   "提交",
+	GitRestoreTargetWorktree:                                 "工作区",
+	GitRestoreTargetWorktreeInfo:                             "仅恢复工作区，保留已暂存的更改",
+	GitRestoreTargetStaged:                                   "索引 (暂存区)",
+	GitRestoreTargetStagedInfo:                               "仅恢复索引，保留工作区",
+	GitRestoreTargetBoth:                                     "索引和工作区",
+	GitRestoreTargetBothInfo:                                 "文件将与该版本完全一致",
+	GitRestoreOverwriteConfirmation:                          "%s 的本地更改将被 %s 时的版本覆盖 (%s)，是否继续？",
+	GitRestoreRemoveConfirmation:                             "%s 在 %s 时不存在，将连同本地更改一起被删除 (%s)，是否继续？",
+	CommitLogFiltered:                                        "已筛选",
+	CommitLogJumpNotFound:                                    "%s 不在此提交日志中",
+	GitCommitLogFilterTitle:                                  "筛选提交日志",
+	GitCommitLogFilterMessageTitle:                           "提交信息 (--grep)",
+	GitCommitLogFilterAuthorTitle:                            "作者 (--author)",
+	GitCommitLogFilterSinceTitle:                             "起始日期 (--since)",
+	GitCommitLogFilterUntilTitle:                             "结束日期 (--until)",
+	GitCommitLogFilterPathTitle:                              "路径 (-- <path>)",
+	GitCommitLogFilterPickaxeTextTitle:                       "新增或删除的文本 (-S)",
+	GitCommitLogFilterPickaxeRegexTitle:                      "匹配正则的变更行 (-G)",
+	GitCommitLogFilterRegexPlaceholder:                       "正则表达式，不区分大小写",
+	GitCommitLogFilterDatePlaceholder:                        "例如 2024-01-31 或 2 weeks ago",
+	GitCommitLogFilterPathPlaceholder:                        "文件或目录，例如 src/main.go",
+	GitCommitLogFilterPickaxeConflict:                        "-S 和 -G 不能同时使用",
+	GitCommitLogFilterGraphHint:                              "筛选提交日志时不显示提交图",
+	CommitLogScopeCurrentBranch:                              "当前分支",
+	CommitLogScopeCurrentBranchInfo:                          "HEAD 的历史",
+	CommitLogScopeAllRefs:                                    "所有引用",
+	CommitLogScopeAllRefsInfo:                                "所有分支、远程分支和标签的历史",
+	CommitLogScopeSelectedBranches:                           "选定的分支",
+	CommitLogScopeSelectedBranchesInfo:                       "所勾选的本地和远程分支的历史",
+	CommitLogScopeFirstParent:                                "仅第一父提交",
+	CommitLogScopeFirstParentInfo:                            "仅沿合并的第一父提交追溯 HEAD 的历史 (--first-parent)",
+	GitCommitLogScopeOptionTitle:                             "提交日志图的范围",
+	GitCommitLogScopeBranchesTitle:                           "显示已勾选分支的提交日志图",
+	GitCommitLogScopeBranchesCheckedCount:                    "已勾选 %d / %d 项",
+	GitCommitLogScopeNoBranch:                                "没有可选择的分支",
+	CommitLogFilesTitle:                                      "%s 中变更的文件",
+	CommitLogFilesAgainstParent:                              "(与父提交 %d %s 比较)",
+	GitCommitParentOptionTitle:                               "将合并提交 %s 与以下比较",
+	GitCommitParentOptionName:                                "父提交 %d",
 	GitDeleteBranchTitle:                                     "删除分支",
 	GitDeleteBranchComfirmPrompt:                             "您确定要删除以下分支吗 \n [%s]",
 	DeletingBranch:                                           "正在删除分支...",
+	GitBatchDiscardFilesConfirmation:                         "确定要放弃以下 %d 个文件的所有更改吗？ \n * 这也会删除未跟踪的文件",
+	GitBatchStashFilesConfirmation:                           "确定要储藏以下 %d 个文件的更改吗？",
+	GitBatchDeleteBranchesConfirmation:                       "确定要删除以下 %d 个分支吗？",
+	GitBatchDropStashesConfirmation:                          "确定要丢弃以下 %d 个储藏吗？",
+	GitBatchOperationMoreItems:                               " ... 以及其他 %d 项",
+	GitBatchOperationTitle:                                   "批量操作",
+	GitBatchOperationProcessing:                              "处理中...",
+	GitDiscardSkippedConflictFile:                            "已跳过 [%s]，文件处于冲突状态",
+	DiffOptionsContextLines:                                  "上下文: %d",
+	DiffOptionsAlgorithm:                                     "算法: %s",
+	DiffOptionsRenamesOn:                                     "重命名检测: 开",
+	DiffOptionsRenamesOff:                                    "重命名检测: 关",
+	DiffOptionsIgnoreSpaceChange:                             "忽略空白变化",
+	DiffOptionsIgnoreAllSpace:                                "忽略所有空白",
+	DiffOptionsReadOnly:                                      "* 当前差异选项下无法暂存或放弃区块/行",
+	FilePreviewBinaryFile:                                    "二进制文件，无法以文本差异显示",
+	FilePreviewBefore:                                        "修改前 (HEAD)",
+	FilePreviewAfter:                                         "修改后 (工作区)",
+	FilePreviewSize:                                          "大小",
+	FilePreviewSizeChange:                                    "大小变化",
+	FilePreviewMimeType:                                      "类型",
+	FilePreviewImageDimensions:                               "尺寸",
+	FilePreviewNotExist:                                      "(不存在)",
+	FilePreviewLargeFilePage:                                 "大文件 (%s)，差异每页显示 %d 行：第 %d 页",
+	FilePreviewLastPage:                                      "(最后一页)",
+	BlameTitle:                                               "Blame (逐行追溯)",
+	BlameUnavailable:                                         "当前选择的文件无法显示 blame (例如未跟踪或新添加的文件)",
+	BlameNotCommittedYet:                                     "尚未提交",
+	RelativeTimeJustNow:                                      "刚刚",
+	RelativeTimeMinutesAgo:                                   "%d 分钟前",
+	RelativeTimeHoursAgo:                                     "%d 小时前",
+	RelativeTimeDaysAgo:                                      "%d 天前",
+	RelativeTimeMonthsAgo:                                    "%d 个月前",
+	RelativeTimeYearsAgo:                                     "%d 年前",
 }
 
 // for about gitti
@@ -432,9 +766,114 @@ var zhHansGlobalKeyBinding = []GlobalKeyBindingMappingFormat{
 		TitleOrInfoLine: "在已暂存和未暂存的差异详细信息面板之间导航",
 		LineType:        INFO,
 	},
+	{
+		KeyBindingLine:  "m",
+		TitleOrInfoLine: "标记或取消标记所选项目以进行批量操作（文件、分支和储藏）",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "M",
+		TitleOrInfoLine: "清除当前列表的所有标记",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "t",
+		TitleOrInfoLine: "在列表和目录树视图之间切换已修改文件面板",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "v",
+		TitleOrInfoLine: "在文件差异详细信息面板中切换区块/行模式",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "|",
+		TitleOrInfoLine: "在统一视图和并排视图之间切换差异详情面板（左侧为旧内容，右侧为新内容）",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "w",
+		TitleOrInfoLine: "循环切换差异空白选项（全部显示 / 忽略空白变化 / 忽略所有空白）",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "{ / }",
+		TitleOrInfoLine: "减少或增加差异上下文行数",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "D",
+		TitleOrInfoLine: "循环切换差异算法（myers / patience / histogram）",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "R",
+		TitleOrInfoLine: "切换差异的重命名检测",
+		LineType:        INFO,
+	},
 	{
 		KeyBindingLine:  "- / +",
 		TitleOrInfoLine: "增大或减小左侧面板宽度比例 [!!]",
 		LineType:        INFO,
 	},
+	{
+		KeyBindingLine:  "< / >",
+		TitleOrInfoLine: "大文件差异的上一页 / 下一页",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "b",
+		TitleOrInfoLine: "在详情面板切换所选修改文件的 blame",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "o",
+		TitleOrInfoLine: "继续、跳过或中止正在进行的合并、变基、拣选、还原或二分查找",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "T",
+		TitleOrInfoLine: "在 git config 配置的合并工具（冲突文件）或差异工具中打开所选的修改文件",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "g",
+		TitleOrInfoLine: "暂存、取消暂存、丢弃或储藏匹配路径规格或通配模式（例如 *.pb.go、src/**/*.ts）的修改文件",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "i",
+		TitleOrInfoLine: "按路径、扩展名或目录忽略所选未跟踪文件，写入根 .gitignore、最近的 .gitignore 或 .git/info/exclude",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "C",
+		TitleOrInfoLine: "清理未跟踪文件：预览 git clean 将删除的内容（可选包含未跟踪目录和忽略的文件），仅删除已勾选的条目",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "I",
+		TitleOrInfoLine: "在已修改文件面板中显示或隐藏忽略的文件，选中后将显示匹配的 .gitignore 规则",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "f",
+		TitleOrInfoLine: "将选中的已修改文件恢复到某个分支、标签或最近提交时的版本，或将选中提交所修改的文件恢复到该提交时的版本（工作区、索引或两者）",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "/",
+		TitleOrInfoLine: "按提交信息、作者、日期范围、路径或 pickaxe (-S/-G) 筛选提交日志，在提交日志面板按 esc 清除筛选",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "G",
+		TitleOrInfoLine: "选择提交日志图的范围：当前分支、所有引用、选定的分支或仅第一父提交",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "enter",
+		TitleOrInfoLine: "在提交日志中列出提交变更的文件以查看各文件的差异（合并提交需选择比较的父提交），按 esc 返回",
+		LineType:        INFO,
+	},
 }
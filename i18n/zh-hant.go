@@ -77,6 +77,8 @@ var zH_HANT = LanguageMapping{
 		"[space] 暫存此更改",
 		"[e] 編輯",
 		"[r] 解決衝突",
+		"[T] 合併工具",
+		"[g] 模式匹配",
 		"[enter] 查看修改內容",
 		"[?] 全域快捷鍵",
 	},
@@ -86,6 +88,9 @@ var zH_HANT = LanguageMapping{
 		"[s] 儲藏 (stash)",
 		"[S] 儲藏所有變更",
 		"[d] 捨棄變更",
+		"[T] 差異工具",
+		"[f] 從版本還原",
+		"[g] 模式匹配",
 		"[enter] 查看修改內容",
 		"[?] 全域快捷鍵",
 	},
@@ -95,20 +100,83 @@ var zH_HANT = LanguageMapping{
 		"[s] 儲藏 (stash)",
 		"[S] 儲藏所有變更",
 		"[d] 捨棄變更",
+		"[i] 忽略 (未追蹤)",
+		"[T] 差異工具",
+		"[I] 顯示/隱藏忽略的檔案",
+		"[f] 從版本還原",
+		"[g] 模式匹配",
 		"[enter] 查看修改內容",
 		"[?] 全域快捷鍵",
 	},
 	KeyBindingModifiedFilesComponentNone: []string{
 		"[?] 全域快捷鍵",
 	},
+	KeyBindingModifiedFilesComponentDirectory: []string{
+		"[space] 暫存/取消暫存目錄",
+		"[d] 放棄目錄變更",
+		"[enter] 展開/摺疊",
+		"[t] 切換樹狀檢視",
+		"[?] 全域快捷鍵",
+	},
+	KeyBindingModifiedFilesComponentIgnored: []string{
+		"[I] 隱藏忽略的檔案",
+		"[t] 切換樹狀檢視",
+		"[enter] 查看匹配的忽略規則",
+		"[?] 全域快捷鍵",
+	},
 	KeyBindingCommitLogComponent: []string{
 		"[↑/↓] 上下移動",
-		"[enter] 查看提交日誌內容",
+		"[enter] 查看變更的檔案",
+		"[f] 還原此提交時的檔案",
+		"[/] 篩選提交日誌",
+		"[G] 提交圖範圍",
+		"[?] 全域快捷鍵",
+	},
+	KeyBindingCommitLogComponentFiltered: []string{
+		"[↑/↓] 上下移動",
+		"[enter] 查看變更的檔案",
+		"[f] 還原此提交時的檔案",
+		"[/] 修改篩選",
+		"[esc] 清除篩選",
+		"[G] 提交圖範圍",
+		"[?] 全域快捷鍵",
+	},
+	KeyBindingCommitLogComponentFilesView: []string{
+		"[↑/↓] 上下移動",
+		"[enter] 查看檔案差異",
+		"[f] 還原此提交時的檔案",
+		"[esc] 返回提交日誌",
 		"[?] 全域快捷鍵",
 	},
 	KeyBindingKeyDetailComponent: []string{
 		"[←/→] 左右移動",
 		"[↑/↓] 上下移動",
+		"[|] 切換並排差異檢視",
+		"[esc] 返回",
+		"[?] 全域快捷鍵",
+	},
+	KeyBindingKeyDetailComponentFileDiff: []string{
+		"[↑/↓] 在區塊/行之間移動",
+		"[v] 切換區塊/行模式",
+		"[space] 暫存/取消暫存區塊/行",
+		"[d] 放棄區塊/行",
+		"[←/→] 左右移動",
+		"[|] 切換並排差異檢視",
+		"[esc] 返回",
+		"[?] 全域快捷鍵",
+	},
+	KeyBindingKeyDetailComponentPagedDiff: []string{
+		"[←/→] 左右移動",
+		"[↑/↓] 上下移動",
+		"[<] 上一頁",
+		"[>] 下一頁",
+		"[esc] 返回",
+		"[?] 全域快捷鍵",
+	},
+	KeyBindingKeyDetailComponentBlame: []string{
+		"[↑/↓] 在行之間移動",
+		"[enter] 跳至提交",
+		"[b] 返回差異",
 		"[esc] 返回",
 		"[?] 全域快捷鍵",
 	},
@@ -123,6 +191,26 @@ var zH_HANT = LanguageMapping{
 	KeyBindingKeyStashComponentNone: []string{
 		"[?] 全域按鍵綁定",
 	},
+	KeyBindingLocalBranchComponentMarked: []string{
+		"[d] 刪除已標記的分支",
+		"[m] 標記/取消標記",
+		"[M] 清除所有標記",
+		"[?] 全域快捷鍵",
+	},
+	KeyBindingModifiedFilesComponentMarked: []string{
+		"[space] 暫存/取消暫存已標記的檔案",
+		"[s] 儲藏已標記的檔案",
+		"[d] 放棄已標記檔案的變更",
+		"[m] 標記/取消標記",
+		"[M] 清除所有標記",
+		"[?] 全域快捷鍵",
+	},
+	KeyBindingKeyStashComponentMarked: []string{
+		"[d] 捨棄已標記的儲藏",
+		"[m] 標記/取消標記",
+		"[M] 清除所有標記",
+		"[?] 全域快捷鍵",
+	},
 	KeyBindingForCommitPopUp: []string{
 		"[tab] 移至下一個輸入框",
 		"[shift+tab] 移至上一個輸入框",
@@ -213,6 +301,106 @@ var zH_HANT = LanguageMapping{
 	KeyBindingForCreateBranchBasedOnRemoteOutputPopUp: []string{
 		"[esc] 關閉",
 	},
+	KeyBindingForGitBatchOperationConfirmPromptPopUp: []string{
+		"[enter] 繼續",
+		"[esc] 取消 / 關閉",
+	},
+	KeyBindingForGitBatchOperationOutputPopUp: []string{
+		"[esc] 關閉",
+	},
+	KeyBindingForGitConflictEditorPopUp: []string{
+		"[↑/↓] 上一個/下一個區塊",
+		"[1] ours",
+		"[2] theirs",
+		"[3] 兩者",
+		"[4] base",
+		"[backspace] 復原",
+		"[enter] 寫回",
+		"[esc] 取消",
+	},
+	KeyBindingForGitRepoOperationOptionPopUp: []string{
+		"[↑/↓] 上下移動",
+		"[enter] 選擇操作",
+		"[esc] 取消 / 關閉",
+	},
+	KeyBindingForGitRepoOperationOutputPopUp: []string{
+		"[esc] 關閉",
+	},
+	KeyBindingForGitMergeToolResultPopUp: []string{
+		"[enter/esc] 關閉",
+	},
+	KeyBindingForGitPathspecPopUp: []string{
+		"[enter] 選擇對匹配檔案的操作",
+		"[esc] 取消",
+	},
+	KeyBindingForGitPathspecActionOptionPopUp: []string{
+		"[↑/↓] 上下移動",
+		"[enter] 選擇操作",
+		"[esc] 取消 / 關閉",
+	},
+	KeyBindingForGitIgnoreRuleOptionPopUp: []string{
+		"[↑/↓] 上下移動",
+		"[enter] 選擇規則",
+		"[esc] 取消 / 關閉",
+	},
+	KeyBindingForGitIgnoreTargetOptionPopUp: []string{
+		"[↑/↓] 上下移動",
+		"[enter] 寫入規則",
+		"[esc] 取消 / 關閉",
+	},
+	KeyBindingForGitCleanPopUp: []string{
+		"[↑/↓] 上下移動",
+		"[space] 勾選 / 取消",
+		"[A] 全部勾選 / 取消",
+		"[d] 目錄",
+		"[x] 忽略的檔案",
+		"[enter] 刪除已勾選",
+		"[esc] 取消",
+	},
+	KeyBindingForGitRestoreSourcePopUp: []string{
+		"[↑/↓] 上下移動",
+		"[enter] 選擇版本",
+		"[esc] 取消 / 關閉",
+	},
+	KeyBindingForGitRestoreFilePopUp: []string{
+		"[↑/↓] 上下移動",
+		"[enter] 選擇檔案",
+		"[esc] 取消 / 關閉",
+	},
+	KeyBindingForGitRestoreTargetOptionPopUp: []string{
+		"[↑/↓] 上下移動",
+		"[enter] 還原",
+		"[esc] 取消 / 關閉",
+	},
+	KeyBindingForGitRestoreConfirmPromptPopUp: []string{
+		"[enter] 繼續還原",
+		"[esc] 取消 / 關閉",
+	},
+	KeyBindingForGitCommitLogFilterPopUp: []string{
+		"[tab] 移至下一個輸入框",
+		"[shift+tab] 移至上一個輸入框",
+		"[enter] 套用篩選（全部留空則清除）",
+		"[esc] 取消 / 關閉",
+	},
+	KeyBindingForGitCommitLogScopeOptionPopUp: []string{
+		"[↑/↓] 上下移動",
+		"[enter] 選擇",
+		"[esc] 取消 / 關閉",
+	},
+	KeyBindingForGitCommitLogScopeBranchesPopUp: []string{
+		"[↑/↓] 上下移動",
+		"[space] 勾選 / 取消",
+		"[enter] 顯示已勾選的提交圖",
+		"[esc] 取消 / 關閉",
+	},
+	KeyBindingForGitCommitParentOptionPopUp: []string{
+		"[↑/↓] 上下移動",
+		"[enter] 與此父提交比較",
+		"[esc] 取消 / 關閉",
+	},
+	KeyBindingForGitApplyPatchErrorPopUp: []string{
+		"[enter/esc] 關閉",
+	},
 	KeyBindingForGlobalKeyBindingPopUp: []string{
 		"[esc] 關閉",
 	},
@@ -282,6 +470,8 @@ var zH_HANT = LanguageMapping{
 	GitDiscardUntrackedConfirmation:                          "確定要捨棄 [%s] 的未追蹤變更嗎？",
 	GitDiscardNewlyAddedorCopyConfirmation:                   "確定要捨棄 [%s] 的新追蹤或複製變更嗎？ \n * 這也將移除未追蹤的變更",
 	GitDiscardAndRevertRenameConfirmation:                    "您確定要放棄 [%s] 的變更並復原重新命名嗎？",
+	GitDiscardPartialConfirmation:                            "確定要放棄 [%s] 的以下變更嗎？",
+	GitDiscardDirectoryConfirmation:                          "確定要放棄 [%s] 下的所有變更嗎？ \n * 這也會刪除其中未追蹤的檔案",
 	GitStashAllTitle:                                         "儲藏所有檔案",
 	GitStashFileTitle:                                        "儲藏檔案",
 	GitStashApplyTitle:                                       "套用儲藏",
@@ -304,9 +494,153 @@ var zH_HANT = LanguageMapping{
 	GitResolveConflictResetInfo:                              "恢復 %s 中的衝突標記（將捨棄已暫存的變更）",
 	GitResolveConflictAcceptLocalChangesInfo:                 "接受衝突檔案 %s 的本地變更",
 	GitResolveConflictAcceptIncomingChangesInfo:              "接受衝突檔案 %s 的傳入變更",
+	GitResolveConflictEditor:                                 "逐個區塊解決",
+	GitResolveConflictEditorInfo:                             "為 %s 中的每個衝突區塊選擇 ours、theirs、兩者或 base",
+	GitConflictEditorTitle:                                   "衝突編輯器：%s (已解決 %d/%d 個區塊)",
+	GitConflictEditorNoConflictBlock:                         "未找到衝突標記，按 enter 將檔案標記為已解決",
+	GitConflictEditorNotStagedInfo:                           "只有在所有區塊都解決後才會暫存檔案",
+	GitConflictEditorResolutionOurs:                          "ours",
+	GitConflictEditorResolutionTheirs:                        "theirs",
+	GitConflictEditorResolutionBoth:                          "兩者",
+	GitConflictEditorResolutionBase:                          "base",
+	GitConflictEditorResolutionNone:                          "未解決",
+	RepoOperationMerging:                                     "合併中",
+	RepoOperationRebasing:                                    "變基中",
+	RepoOperationApplyingMailbox:                             "套用補丁中",
+	RepoOperationCherryPicking:                               "揀選中",
+	RepoOperationReverting:                                   "還原中",
+	RepoOperationBisecting:                                   "二分搜尋中",
+	GitRepoOperationOptionTitle:                              "%s，請選擇操作",
+	GitRepoOperationContinue:                                 "繼續",
+	GitRepoOperationSkip:                                     "跳過",
+	GitRepoOperationAbort:                                    "中止",
+	GitRepoOperationProcessing:                               "處理中...",
+	GitResolveConflictMergeTool:                              "在合併工具中開啟",
+	GitMergeToolResultTitle:                                  "合併工具",
+	GitMergeToolResolved:                                     "[%s] 的衝突已解決",
+	GitMergeToolUnresolved:                                   "[%s] 仍有衝突",
+	GitMergeToolFailed:                                       "合併工具出錯退出：%s",
+	GitApplyPatchErrorTitle:                                  "套用變更",
+	GitApplyPatchFailed:                                      "無法套用所選變更：%s",
+	GitPathspecTitle:                                         "按模式匹配檔案",
+	GitPathspecPlaceholder:                                   "輸入路徑規格或萬用模式，例如 *.pb.go、src/**/*.ts",
+	GitPathspecHint:                                          "符合模式的修改檔案將在此預覽",
+	GitPathspecInvalid:                                       "無效的模式",
+	GitPathspecMatchedCount:                                  "符合 %d 個檔案",
+	GitPathspecActionOptionTitle:                             "符合 \"%s\" 的檔案",
+	GitPathspecActionStage:                                   "暫存",
+	GitPathspecActionStageInfo:                               "暫存符合的 %d 個檔案",
+	GitPathspecActionUnstage:                                 "取消暫存",
+	GitPathspecActionUnstageInfo:                             "取消暫存符合的 %d 個檔案，保留修改",
+	GitPathspecActionDiscard:                                 "捨棄",
+	GitPathspecActionDiscardInfo:                             "捨棄符合的 %d 個檔案的修改",
+	GitPathspecActionStash:                                   "儲藏",
+	GitPathspecActionStashInfo:                               "儲藏符合的 %d 個檔案的修改",
+	GitIgnoreRuleOptionTitle:                                 "忽略 \"%s\" 的方式",
+	GitIgnoreRulePath:                                        "僅此路徑",
+	GitIgnoreRuleExtension:                                   "所有此副檔名的檔案",
+	GitIgnoreRuleDirectory:                                   "此檔案所在的目錄",
+	GitIgnoreTargetOptionTitle:                               "將 \"%s\" 的忽略規則寫入",
+	GitIgnoreTargetRoot:                                      "根目錄 .gitignore",
+	GitIgnoreTargetNested:                                    "最近的 .gitignore",
+	GitIgnoreTargetExclude:                                   ".git/info/exclude（僅限此複製）",
+	GitIgnoreRulePreview:                                     "將 \"%s\" 附加到 %s",
+	GitCleanTitle:                                            "清理未追蹤檔案",
+	GitCleanOptions:                                          "[d] 未追蹤目錄：%s   [x] 忽略的檔案：%s",
+	GitCleanOptionOn:                                         "包含",
+	GitCleanOptionOff:                                        "不包含",
+	GitCleanIgnoredKeep:                                      "保留",
+	GitCleanIgnoredInclude:                                   "一併刪除 (-x)",
+	GitCleanIgnoredOnly:                                      "僅刪除這些 (-X)",
+	GitCleanNothingToClean:                                   "沒有需要清理的內容",
+	GitCleanCheckedCount:                                     "已勾選 %d / %d 項，僅刪除已勾選的項目",
+	ModifiedFilesShowingIgnored:                              "含忽略的檔案",
+	IgnoredFileMatchingRule:                                  "匹配的忽略規則：",
+	IgnoredFileNoMatchingRule:                                "已沒有忽略規則匹配此檔案",
+	IgnoredFileRuleSource:                                    "來自 %s 第 %s 行",
+	GitRestoreSourceTitle:                                    "還原 %s 自",
+	GitRestoreFileTitle:                                      "還原 %s 時的檔案",
+	GitRestoreTargetOptionTitle:                              "將 %s 還原到 %s 時的版本至",
+	GitRestoreSourceBranch:                                   "分支",
+	GitRestoreSourceRemoteBranch:                             "遠端",
+	GitRestoreSourceTag:                                      "標籤",
+	GitRestoreSourceCommit:                                   "提交",
+	GitRestoreTargetWorktree:                                 "工作區",
+	GitRestoreTargetWorktreeInfo:                             "僅還原工作區，保留已暫存的變更",
+	GitRestoreTargetStaged:                                   "索引 (暫存區)",
+	GitRestoreTargetStagedInfo:                               "僅還原索引，保留工作區",
+	GitRestoreTargetBoth:                                     "索引和工作區",
+	GitRestoreTargetBothInfo:                                 "檔案將與該版本完全一致",
+	GitRestoreOverwriteConfirmation:                          "%s 的本機變更將被 %s 時的版本覆寫 (%s)，是否繼續？",
+	GitRestoreRemoveConfirmation:                             "%s 在 %s 時不存在，將連同本機變更一起被刪除 (%s)，是否繼續？",
+	CommitLogFiltered:                                        "已篩選",
+	CommitLogJumpNotFound:                                    "%s 不在此提交日誌中",
+	GitCommitLogFilterTitle:                                  "篩選提交日誌",
+	GitCommitLogFilterMessageTitle:                           "提交訊息 (--grep)",
+	GitCommitLogFilterAuthorTitle:                            "作者 (--author)",
+	GitCommitLogFilterSinceTitle:                             "起始日期 (--since)",
+	GitCommitLogFilterUntilTitle:                             "結束日期 (--until)",
+	GitCommitLogFilterPathTitle:                              "路徑 (-- <path>)",
+	GitCommitLogFilterPickaxeTextTitle:                       "新增或刪除的文字 (-S)",
+	GitCommitLogFilterPickaxeRegexTitle:                      "符合正規表示式的變更行 (-G)",
+	GitCommitLogFilterRegexPlaceholder:                       "正規表示式，不區分大小寫",
+	GitCommitLogFilterDatePlaceholder:                        "例如 2024-01-31 或 2 weeks ago",
+	GitCommitLogFilterPathPlaceholder:                        "檔案或目錄，例如 src/main.go",
+	GitCommitLogFilterPickaxeConflict:                        "-S 和 -G 不能同時使用",
+	GitCommitLogFilterGraphHint:                              "篩選提交日誌時不顯示提交圖",
+	CommitLogScopeCurrentBranch:                              "目前分支",
+	CommitLogScopeCurrentBranchInfo:                          "HEAD 的歷史",
+	CommitLogScopeAllRefs:                                    "所有參照",
+	CommitLogScopeAllRefsInfo:                                "所有分支、遠端分支和標籤的歷史",
+	CommitLogScopeSelectedBranches:                           "選定的分支",
+	CommitLogScopeSelectedBranchesInfo:                       "所勾選的本地和遠端分支的歷史",
+	CommitLogScopeFirstParent:                                "僅第一父提交",
+	CommitLogScopeFirstParentInfo:                            "僅沿合併的第一父提交追溯 HEAD 的歷史 (--first-parent)",
+	GitCommitLogScopeOptionTitle:                             "提交日誌圖的範圍",
+	GitCommitLogScopeBranchesTitle:                           "顯示已勾選分支的提交日誌圖",
+	GitCommitLogScopeBranchesCheckedCount:                    "已勾選 %d / %d 項",
+	GitCommitLogScopeNoBranch:                                "沒有可選擇的分支",
+	CommitLogFilesTitle:                                      "%s 中變更的檔案",
+	CommitLogFilesAgainstParent:                              "(與父提交 %d %s 比較)",
+	GitCommitParentOptionTitle:                               "將合併提交 %s 與以下比較",
+	GitCommitParentOptionName:                                "父提交 %d",
 	GitDeleteBranchTitle:                                     "刪除分支",
 	GitDeleteBranchComfirmPrompt:                             "您確定要刪除以下分支嗎 \n [%s]",
 	DeletingBranch:                                           "正在刪除分支...",
+	GitBatchDiscardFilesConfirmation:                         "確定要放棄以下 %d 個檔案的所有變更嗎？ \n * 這也會刪除未追蹤的檔案",
+	GitBatchStashFilesConfirmation:                           "確定要儲藏以下 %d 個檔案的變更嗎？",
+	GitBatchDeleteBranchesConfirmation:                       "確定要刪除以下 %d 個分支嗎？",
+	GitBatchDropStashesConfirmation:                          "確定要捨棄以下 %d 個儲藏嗎？",
+	GitBatchOperationMoreItems:                               " ... 以及其他 %d 項",
+	GitBatchOperationTitle:                                   "批次操作",
+	GitBatchOperationProcessing:                              "處理中...",
+	GitDiscardSkippedConflictFile:                            "已略過 [%s]，檔案處於衝突狀態",
+	DiffOptionsContextLines:                                  "上下文: %d",
+	DiffOptionsAlgorithm:                                     "演算法: %s",
+	DiffOptionsRenamesOn:                                     "重新命名偵測: 開",
+	DiffOptionsRenamesOff:                                    "重新命名偵測: 關",
+	DiffOptionsIgnoreSpaceChange:                             "忽略空白變化",
+	DiffOptionsIgnoreAllSpace:                                "忽略所有空白",
+	DiffOptionsReadOnly:                                      "* 目前差異選項下無法暫存或放棄區塊/行",
+	FilePreviewBinaryFile:                                    "二進位檔案，無法以文字差異顯示",
+	FilePreviewBefore:                                        "修改前 (HEAD)",
+	FilePreviewAfter:                                         "修改後 (工作區)",
+	FilePreviewSize:                                          "大小",
+	FilePreviewSizeChange:                                    "大小變化",
+	FilePreviewMimeType:                                      "類型",
+	FilePreviewImageDimensions:                               "尺寸",
+	FilePreviewNotExist:                                      "(不存在)",
+	FilePreviewLargeFilePage:                                 "大型檔案 (%s)，差異每頁顯示 %d 行：第 %d 頁",
+	FilePreviewLastPage:                                      "(最後一頁)",
+	BlameTitle:                                               "Blame (逐行追溯)",
+	BlameUnavailable:                                         "目前選擇的檔案無法顯示 blame (例如未追蹤或新加入的檔案)",
+	BlameNotCommittedYet:                                     "尚未提交",
+	RelativeTimeJustNow:                                      "剛剛",
+	RelativeTimeMinutesAgo:                                   "%d 分鐘前",
+	RelativeTimeHoursAgo:                                     "%d 小時前",
+	RelativeTimeDaysAgo:                                      "%d 天前",
+	RelativeTimeMonthsAgo:                                    "%d 個月前",
+	RelativeTimeYearsAgo:                                     "%d 年前",
 }
 
 // for about gitti
@@ -432,9 +766,114 @@ var zhHantGlobalKeyBinding = []GlobalKeyBindingMappingFormat{
 		TitleOrInfoLine: "在已暫存和未暫存的差異詳細資訊面板之間導航",
 		LineType:        INFO,
 	},
+	{
+		KeyBindingLine:  "m",
+		TitleOrInfoLine: "標記或取消標記所選項目以進行批次操作（檔案、分支和儲藏）",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "M",
+		TitleOrInfoLine: "清除目前清單的所有標記",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "t",
+		TitleOrInfoLine: "在清單和目錄樹檢視之間切換已修改檔案面板",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "v",
+		TitleOrInfoLine: "在檔案差異詳細資訊面板中切換區塊/行模式",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "|",
+		TitleOrInfoLine: "在統一檢視與並排檢視之間切換差異詳細面板（左側為舊內容，右側為新內容）",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "w",
+		TitleOrInfoLine: "循環切換差異空白選項（全部顯示 / 忽略空白變化 / 忽略所有空白）",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "{ / }",
+		TitleOrInfoLine: "減少或增加差異上下文行數",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "D",
+		TitleOrInfoLine: "循環切換差異演算法（myers / patience / histogram）",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "R",
+		TitleOrInfoLine: "切換差異的重新命名偵測",
+		LineType:        INFO,
+	},
 	{
 		KeyBindingLine:  "- / +",
 		TitleOrInfoLine: "增大或減小左側面板寬度比例 [!!]",
 		LineType:        INFO,
 	},
+	{
+		KeyBindingLine:  "< / >",
+		TitleOrInfoLine: "大型檔案差異的上一頁 / 下一頁",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "b",
+		TitleOrInfoLine: "在詳細面板切換所選修改檔案的 blame",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "o",
+		TitleOrInfoLine: "繼續、跳過或中止正在進行的合併、變基、揀選、還原或二分搜尋",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "T",
+		TitleOrInfoLine: "在 git config 設定的合併工具（衝突檔案）或差異工具中開啟所選的修改檔案",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "g",
+		TitleOrInfoLine: "暫存、取消暫存、捨棄或儲藏符合路徑規格或萬用模式（例如 *.pb.go、src/**/*.ts）的修改檔案",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "i",
+		TitleOrInfoLine: "按路徑、副檔名或目錄忽略所選未追蹤檔案，寫入根 .gitignore、最近的 .gitignore 或 .git/info/exclude",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "C",
+		TitleOrInfoLine: "清理未追蹤檔案：預覽 git clean 將刪除的內容（可選包含未追蹤目錄和忽略的檔案），僅刪除已勾選的項目",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "I",
+		TitleOrInfoLine: "在已修改檔案面板中顯示或隱藏忽略的檔案，選取後將顯示匹配的 .gitignore 規則",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "f",
+		TitleOrInfoLine: "將選取的已修改檔案還原到某個分支、標籤或最近提交時的版本，或將選取提交所修改的檔案還原到該提交時的版本（工作區、索引或兩者）",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "/",
+		TitleOrInfoLine: "按提交訊息、作者、日期範圍、路徑或 pickaxe (-S/-G) 篩選提交日誌，在提交日誌面板按 esc 清除篩選",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "G",
+		TitleOrInfoLine: "選擇提交日誌圖的範圍：目前分支、所有參照、選定的分支或僅第一父提交",
+		LineType:        INFO,
+	},
+	{
+		KeyBindingLine:  "enter",
+		TitleOrInfoLine: "在提交日誌中列出提交變更的檔案以查看各檔案的差異（合併提交需選擇比較的父提交），按 esc 返回",
+		LineType:        INFO,
+	},
 }
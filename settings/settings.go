@@ -20,6 +20,12 @@ const (
 	MINLEFTPANELWIDTHRATIO = 0.2
 )
 
+// how the diff will be shown in the detail component panel
+const (
+	DIFFVIEWMODEUNIFIED    = "unified"
+	DIFFVIEWMODESIDEBYSIDE = "side_by_side"
+)
+
 var GITTICONFIGSETTINGS *GittiConfigSettings
 
 type GittiConfigSettings struct {
@@ -33,6 +39,7 @@ type GittiConfigSettings struct {
 	LastUpdateCheckTime             time.Time `json:"last_update_check_time"`
 	AutoUpdate                      bool      `json:"auto_update"`
 	Editor                          string    `json:"editor"`
+	DiffViewMode                    string    `json:"diff_view_mode"`
 }
 
 var GittiDefaultConfigSettings = GittiConfigSettings{
@@ -46,6 +53,7 @@ var GittiDefaultConfigSettings = GittiConfigSettings{
 	LastUpdateCheckTime:             time.Now().UTC(),
 	AutoUpdate:                      true,
 	Editor:                          "vim",
+	DiffViewMode:                    DIFFVIEWMODEUNIFIED,
 }
 
 // getConfigPath returns the config.json path (creates directories if needed)
@@ -110,6 +118,10 @@ func InitOrReadConfig() {
 			saveConfig(cfgPath, cfg)
 		}
 	}
+	if cfg.DiffViewMode != DIFFVIEWMODEUNIFIED && cfg.DiffViewMode != DIFFVIEWMODESIDEBYSIDE {
+		cfg.DiffViewMode = DIFFVIEWMODEUNIFIED
+		saveConfig(cfgPath, cfg)
+	}
 	cfg.FileWatcherDebounceMS = min(cfg.FileWatcherDebounceMS, MAXFILEWATCHERDEBOUNCEMS)
 	cfg.GitFilesActiveRefreshDurationMS = min(cfg.GitFilesActiveRefreshDurationMS, MAXGITFILESACTIVEREFRESHDURATIONMS)
 
@@ -212,3 +224,11 @@ func UpdateEditor(editor string) {
 		saveConfig(cfgPath, *GITTICONFIGSETTINGS)
 	}
 }
+
+func UpdateDiffViewMode(diffViewMode string) {
+	GITTICONFIGSETTINGS.DiffViewMode = diffViewMode
+	cfgPath, err := getConfigPath()
+	if err == nil {
+		saveConfig(cfgPath, *GITTICONFIGSETTINGS)
+	}
+}
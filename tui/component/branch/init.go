@@ -26,7 +26,18 @@ func InitBranchList(m *types.GittiModel) {
 		latestBranchArray = append(latestBranchArray, GitBranchItem(branch))
 	}
 
-	m.CurrentRepoBranchesInfoList = list.New(latestBranchArray, GitBranchItemDelegate{}, m.WindowLeftPanelWidth, m.LocalBranchesComponentPanelHeight)
+	// drop the marks of the branches that no longer exist (the checked out branch can't be marked)
+	existingBranches := make(map[string]bool, len(latestBranchArray))
+	for _, branch := range latestBranchArray[1:] {
+		existingBranches[branch.(GitBranchItem).BranchName] = true
+	}
+	for markedBranch := range m.ListMarkedItems.LocalBranchComponent {
+		if !existingBranches[markedBranch] {
+			delete(m.ListMarkedItems.LocalBranchComponent, markedBranch)
+		}
+	}
+
+	m.CurrentRepoBranchesInfoList = list.New(latestBranchArray, GitBranchItemDelegate{MarkedItems: m.ListMarkedItems.LocalBranchComponent}, m.WindowLeftPanelWidth, m.LocalBranchesComponentPanelHeight)
 	m.CurrentRepoBranchesInfoList.SetShowPagination(false)
 	m.CurrentRepoBranchesInfoList.SetShowStatusBar(false)
 	m.CurrentRepoBranchesInfoList.SetFilteringEnabled(false)
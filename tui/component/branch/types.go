@@ -19,8 +19,10 @@ import (
 //
 // ---------------------------------
 type (
-	GitBranchItemDelegate struct{}
-	GitBranchItem         struct {
+	GitBranchItemDelegate struct {
+		MarkedItems map[string]bool // the branches that were marked for batch operation
+	}
+	GitBranchItem struct {
 		BranchName   string
 		IsCheckedOut bool
 	}
@@ -46,6 +48,10 @@ func (d GitBranchItemDelegate) Render(w io.Writer, m list.Model, index int, list
 	}
 
 	componentWidth := m.Width() - constant.ListItemOrTitleWidthPad
+	str = utils.TruncateString(str, componentWidth)
+	if d.MarkedItems[i.BranchName] {
+		str = style.MarkedItemStyle.Render("✓") + str[1:]
+	}
 
 	var fn func(...string) string
 	if index == m.Index() {
@@ -57,7 +63,6 @@ func (d GitBranchItemDelegate) Render(w io.Writer, m list.Model, index int, list
 			return style.ItemStyle.Render("  " + strings.Join(s, " "))
 		}
 	}
-	str = utils.TruncateString(str, componentWidth)
 
 	fmt.Fprint(w, fn(str))
 }
@@ -0,0 +1,112 @@
+package commitlog
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"charm.land/bubbles/v2/list"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+	"github.com/gohyuhan/gitti/tui/utils"
+)
+
+// ---------------------------------
+//
+// for the files changed by a commit, listed within the commit log panel
+//
+// ---------------------------------
+type (
+	GitCommitLogFileItemDelegate struct{}
+	GitCommitLogFileItem         struct {
+		CommitFile git.CommitFile
+	}
+)
+
+func (i GitCommitLogFileItem) FilterValue() string {
+	return i.CommitFile.FilePathName
+}
+
+func (d GitCommitLogFileItemDelegate) Height() int                             { return 1 }
+func (d GitCommitLogFileItemDelegate) Spacing() int                            { return 0 }
+func (d GitCommitLogFileItemDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+func (d GitCommitLogFileItemDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	i, ok := listItem.(GitCommitLogFileItem)
+	if !ok {
+		return
+	}
+
+	componentWidth := m.Width() - constant.ListItemOrTitleWidthPad - 4
+
+	status := style.StagedFileStyle.Render(i.CommitFile.Status)
+	if i.CommitFile.Status == "D" {
+		status = style.UnstagedFileStyle.Render(i.CommitFile.Status)
+	}
+
+	lineChanges := ""
+	if i.CommitFile.IsBinary {
+		lineChanges = " bin"
+	} else if i.CommitFile.Added > 0 || i.CommitFile.Removed > 0 {
+		lineChanges = fmt.Sprintf(" +%d/-%d", i.CommitFile.Added, i.CommitFile.Removed)
+	}
+	filePathName := utils.TruncateString(i.CommitFile.DisplayPathname(), componentWidth-len(lineChanges))
+	str := fmt.Sprintf("%s %s%s", status, filePathName, style.DiffLineNumberStyle.Render(lineChanges))
+
+	var fn func(...string) string
+	if index == m.Index() {
+		fn = func(s ...string) string {
+			return style.SelectedItemStyle.Render("❯ " + strings.Join(s, " "))
+		}
+	} else {
+		fn = func(s ...string) string {
+			return style.ItemStyle.Render("  " + strings.Join(s, " "))
+		}
+	}
+
+	fmt.Fprint(w, fn(str))
+}
+
+// init the list of the files changed by the commit of m.CommitLogFilesView, the selection starts from the first file
+func InitGitCommitLogFilesList(m *types.GittiModel) {
+	filesView := m.CommitLogFilesView
+	commitFiles := m.GitOperations.GitCommitLog.CommitFiles(filesView.CommitHash, filesView.ParentHash, m.DiffOptions)
+	items := make([]list.Item, 0, len(commitFiles))
+	for _, commitFile := range commitFiles {
+		items = append(items, GitCommitLogFileItem{CommitFile: commitFile})
+	}
+
+	m.CurrentRepoCommitLogFilesInfoList = list.New(items, GitCommitLogFileItemDelegate{}, m.WindowLeftPanelWidth-2, m.CommitLogComponentPanelHeight)
+	m.CurrentRepoCommitLogFilesInfoList.SetShowPagination(false)
+	m.CurrentRepoCommitLogFilesInfoList.SetShowStatusBar(false)
+	m.CurrentRepoCommitLogFilesInfoList.SetFilteringEnabled(false)
+	m.CurrentRepoCommitLogFilesInfoList.SetShowFilter(false)
+
+	title := fmt.Sprintf(i18n.LANGUAGEMAPPING.CommitLogFilesTitle, shortHash(filesView.CommitHash))
+	if filesView.ParentNumber > 0 {
+		title += " " + fmt.Sprintf(i18n.LANGUAGEMAPPING.CommitLogFilesAgainstParent, filesView.ParentNumber, shortHash(filesView.ParentHash))
+	}
+	title = fmt.Sprintf("[3] \ue729 %s:", title)
+	m.CurrentRepoCommitLogFilesInfoList.Title = utils.TruncateString(title, m.WindowLeftPanelWidth-constant.ListItemOrTitleWidthPad-2)
+	m.CurrentRepoCommitLogFilesInfoList.Styles.Title = style.TitleStyle
+	m.CurrentRepoCommitLogFilesInfoList.Styles.PaginationStyle = style.PaginationStyle
+	m.CurrentRepoCommitLogFilesInfoList.Styles.TitleBar = style.NewStyle
+	m.CurrentRepoCommitLogFilesInfoList.Styles.HelpStyle = style.NewStyle.MarginTop(0).MarginBottom(0).PaddingTop(0).PaddingBottom(0)
+
+	// Custom Help Model for Count Display
+	m.CurrentRepoCommitLogFilesInfoList.SetShowHelp(true)
+	m.CurrentRepoCommitLogFilesInfoList.KeyMap = list.KeyMap{} // Clear default keybindings to hide them
+	m.CurrentRepoCommitLogFilesInfoList.AdditionalShortHelpKeys = utils.ListCounterHelper(m, &m.CurrentRepoCommitLogFilesInfoList)
+}
+
+// the abbreviated hash to be shown in the title
+func shortHash(commitHash string) string {
+	if len(commitHash) > 7 {
+		return commitHash[:7]
+	}
+	return commitHash
+}
@@ -2,8 +2,10 @@ package commitlog
 
 import (
 	"fmt"
+	"strings"
 
 	"charm.land/bubbles/v2/list"
+	"github.com/gohyuhan/gitti/api/git"
 	"github.com/gohyuhan/gitti/i18n"
 	"github.com/gohyuhan/gitti/tui/constant"
 	"github.com/gohyuhan/gitti/tui/style"
@@ -30,6 +32,7 @@ func InitGitCommitLogList(m *types.GittiModel) bool {
 		latestGitCommitLogItemArray = append(latestGitCommitLogItemArray, GitCommitLogItem{
 			Hash:         commitLog.Hash,
 			Parents:      commitLog.Parents,
+			Refs:         commitLog.Refs,
 			Message:      commitLog.Message,
 			Author:       commitLog.Author,
 			LaneCharList: laneCharList,
@@ -61,7 +64,18 @@ func InitGitCommitLogList(m *types.GittiModel) bool {
 	m.CurrentRepoCommitLogInfoList.SetShowStatusBar(false)
 	m.CurrentRepoCommitLogInfoList.SetFilteringEnabled(false)
 	m.CurrentRepoCommitLogInfoList.SetShowFilter(false)
-	m.CurrentRepoCommitLogInfoList.Title = utils.TruncateString(fmt.Sprintf("[3] \ue729 %s:", i18n.LANGUAGEMAPPING.CommitLog), m.WindowLeftPanelWidth-constant.ListItemOrTitleWidthPad-2)
+	titleName := i18n.LANGUAGEMAPPING.CommitLog
+	if scopeName := commitLogScopeName(m.GitOperations.GitCommitLog.CommitLogScope()); scopeName != "" {
+		titleName = fmt.Sprintf("%s [%s]", titleName, scopeName)
+	}
+	if m.CommitLogJumpNotFoundHash != "" {
+		titleName = fmt.Sprintf("%s (%s)", titleName, fmt.Sprintf(i18n.LANGUAGEMAPPING.CommitLogJumpNotFound, shortHash(m.CommitLogJumpNotFoundHash)))
+	}
+	title := fmt.Sprintf("[3] \ue729 %s:", titleName)
+	if filter := m.GitOperations.GitCommitLog.CommitLogFilter(); !filter.IsEmpty() {
+		title = fmt.Sprintf("[3] \ue729 %s (%s: %s):", titleName, i18n.LANGUAGEMAPPING.CommitLogFiltered, filter.String())
+	}
+	m.CurrentRepoCommitLogInfoList.Title = utils.TruncateString(title, m.WindowLeftPanelWidth-constant.ListItemOrTitleWidthPad-2)
 	m.CurrentRepoCommitLogInfoList.Styles.Title = style.TitleStyle
 	m.CurrentRepoCommitLogInfoList.Styles.PaginationStyle = style.PaginationStyle
 	m.CurrentRepoCommitLogInfoList.Styles.TitleBar = style.NewStyle
@@ -96,3 +110,20 @@ func InitGitCommitLogList(m *types.GittiModel) bool {
 	}
 	return true
 }
+
+// the scope to be shown in the title, nothing is shown for the current branch as it is the default
+func commitLogScopeName(scope git.CommitLogScope) string {
+	switch scope.Mode {
+	case git.COMMITLOGSCOPEALLREFS:
+		return i18n.LANGUAGEMAPPING.CommitLogScopeAllRefs
+	case git.COMMITLOGSCOPEFIRSTPARENT:
+		return i18n.LANGUAGEMAPPING.CommitLogScopeFirstParent
+	case git.COMMITLOGSCOPESELECTEDBRANCHES:
+		branchNames := make([]string, 0, len(scope.Branches))
+		for _, branch := range scope.Branches {
+			branchNames = append(branchNames, branch.Name)
+		}
+		return strings.Join(branchNames, ", ")
+	}
+	return ""
+}
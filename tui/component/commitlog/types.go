@@ -9,6 +9,7 @@ import (
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 
+	"github.com/gohyuhan/gitti/api/git"
 	"github.com/gohyuhan/gitti/tui/constant"
 	"github.com/gohyuhan/gitti/tui/style"
 )
@@ -28,6 +29,7 @@ type (
 	GitCommitLogItem         struct {
 		Hash         string
 		Parents      []string
+		Refs         []git.CommitRef
 		Message      string
 		Author       string
 		LaneCharList []Cell
@@ -75,8 +77,15 @@ func (d GitCommitLogItemDelegate) Render(w io.Writer, m list.Model, index int, l
 	lineBuilder.WriteString(" ")
 	lineBuilder.WriteString(style.NewStyle.Foreground(style.GetColor(i.ColorID)).Render(fmt.Sprintf("%-*s", 3, nameShortForm)))
 	lineBuilder.WriteString(" ")
-	lineBuilder.WriteString(commitGraphLine.String())
-	lineBuilder.WriteString(" ")
+	// the graph is not rendered when the commit log is filtered
+	if commitGraphLine.Len() > 0 {
+		lineBuilder.WriteString(commitGraphLine.String())
+		lineBuilder.WriteString(" ")
+	}
+	if len(i.Refs) > 0 {
+		lineBuilder.WriteString(renderCommitRefBadges(i.Refs))
+		lineBuilder.WriteString(" ")
+	}
 	lineBuilder.WriteString(style.NewStyle.Render(i.Message))
 
 	strContent := lineBuilder.String()
@@ -108,3 +117,21 @@ func (d GitCommitLogItemDelegate) Render(w io.Writer, m list.Model, index int, l
 
 	fmt.Fprint(w, fn(str))
 }
+
+// render the refs of a commit as badges, each colored by the kind of ref
+func renderCommitRefBadges(refs []git.CommitRef) string {
+	badges := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		switch ref.RefType {
+		case git.COMMITREFHEAD:
+			badges = append(badges, style.CommitRefHeadStyle.Render("("+ref.Name+")"))
+		case git.COMMITREFLOCALBRANCH:
+			badges = append(badges, style.CommitRefLocalBranchStyle.Render("("+ref.Name+")"))
+		case git.COMMITREFREMOTEBRANCH:
+			badges = append(badges, style.CommitRefRemoteBranchStyle.Render("("+ref.Name+")"))
+		case git.COMMITREFTAG:
+			badges = append(badges, style.CommitRefTagStyle.Render("(\uf02b "+ref.Name+")"))
+		}
+	}
+	return strings.Join(badges, " ")
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"charm.land/bubbles/v2/list"
+	"github.com/gohyuhan/gitti/api/git"
 	"github.com/gohyuhan/gitti/i18n"
 	"github.com/gohyuhan/gitti/tui/constant"
 	"github.com/gohyuhan/gitti/tui/style"
@@ -17,9 +18,14 @@ import (
 // return bool was to tell if we need to reinit the detail component panel or not
 func InitModifiedFilesList(m *types.GittiModel) bool {
 	latestModifiedFilesArray := m.GitOperations.GitFiles.FilesStatus()
-	items := make([]list.Item, 0, len(latestModifiedFilesArray))
-	for _, modifiedFile := range latestModifiedFilesArray {
-		items = append(items, GitModifiedFilesItem(modifiedFile))
+	var items []list.Item
+	if m.IsModifiedFilesTreeView {
+		items = buildModifiedFilesTreeItems(latestModifiedFilesArray, m.ModifiedFilesCollapsedDirectories)
+	} else {
+		items = make([]list.Item, 0, len(latestModifiedFilesArray))
+		for _, modifiedFile := range latestModifiedFilesArray {
+			items = append(items, GitModifiedFilesItem(modifiedFile))
+		}
 	}
 
 	// get the previous selected file and see if it was within the new list if yes get the latest position of the previous selected file
@@ -31,16 +37,39 @@ func InitModifiedFilesList(m *types.GittiModel) bool {
 			selectedFilesPosition = index
 			break
 		}
+		// a directory stay selected even when the state of the files under it changed
+		previousDirectory, isPreviousDirectory := previousSelectedFile.(GitModifiedFilesDirectoryItem)
+		directory, isDirectory := item.(GitModifiedFilesDirectoryItem)
+		if isPreviousDirectory && isDirectory && previousDirectory.DirectoryPath == directory.DirectoryPath {
+			selectedFilesPosition = index
+			break
+		}
 	}
 
 	previousModifiedFilesCount := len(m.CurrentRepoModifiedFilesInfoList.Items())
 
-	m.CurrentRepoModifiedFilesInfoList = list.New(items, GitModifiedFilesItemDelegate{}, m.WindowLeftPanelWidth, m.ModifiedFilesComponentPanelHeight)
+	// drop the marks of the files that no longer have any changes
+	existingFiles := make(map[string]bool, len(latestModifiedFilesArray))
+	for _, modifiedFile := range latestModifiedFilesArray {
+		existingFiles[modifiedFile.FilePathname] = true
+	}
+	for markedFile := range m.ListMarkedItems.ModifiedFilesComponent {
+		if !existingFiles[markedFile] {
+			delete(m.ListMarkedItems.ModifiedFilesComponent, markedFile)
+		}
+	}
+
+	filesLineChanges := m.GitOperations.GitFiles.FilesLineChanges()
+	title := fmt.Sprintf("[2] \ueae9 %s:%s", i18n.LANGUAGEMAPPING.ModifiedFiles, totalLineChangesTitle(filesLineChanges))
+	if m.GitOperations.GitFiles.IsShowingIgnoredFiles() {
+		title += fmt.Sprintf(" (%s)", i18n.LANGUAGEMAPPING.ModifiedFilesShowingIgnored)
+	}
+	m.CurrentRepoModifiedFilesInfoList = list.New(items, GitModifiedFilesItemDelegate{IsTreeView: m.IsModifiedFilesTreeView, MarkedItems: m.ListMarkedItems.ModifiedFilesComponent, LineChanges: filesLineChanges}, m.WindowLeftPanelWidth, m.ModifiedFilesComponentPanelHeight)
 	m.CurrentRepoModifiedFilesInfoList.SetShowPagination(false)
 	m.CurrentRepoModifiedFilesInfoList.SetShowStatusBar(false)
 	m.CurrentRepoModifiedFilesInfoList.SetFilteringEnabled(false)
 	m.CurrentRepoModifiedFilesInfoList.SetShowFilter(false)
-	m.CurrentRepoModifiedFilesInfoList.Title = utils.TruncateString(fmt.Sprintf("[2] \ueae9 %s:", i18n.LANGUAGEMAPPING.ModifiedFiles), m.WindowLeftPanelWidth-constant.ListItemOrTitleWidthPad-2)
+	m.CurrentRepoModifiedFilesInfoList.Title = utils.TruncateString(title, m.WindowLeftPanelWidth-constant.ListItemOrTitleWidthPad-2)
 	m.CurrentRepoModifiedFilesInfoList.Styles.Title = style.TitleStyle
 	m.CurrentRepoModifiedFilesInfoList.Styles.TitleBar = style.NewStyle
 	m.CurrentRepoModifiedFilesInfoList.Styles.HelpStyle = style.NewStyle.MarginTop(0).MarginBottom(0).PaddingTop(0).PaddingBottom(0)
@@ -71,3 +100,17 @@ func InitModifiedFilesList(m *types.GittiModel) bool {
 	}
 	return true
 }
+
+// the total of lines added and removed across all the files (staged and unstaged), empty when nothing was counted
+func totalLineChangesTitle(filesLineChanges map[string]git.FileLineChanges) string {
+	totalAdded := 0
+	totalRemoved := 0
+	for _, lineChanges := range filesLineChanges {
+		totalAdded += lineChanges.StagedAdded + lineChanges.UnstagedAdded
+		totalRemoved += lineChanges.StagedRemoved + lineChanges.UnstagedRemoved
+	}
+	if totalAdded == 0 && totalRemoved == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" +%d -%d", totalAdded, totalRemoved)
+}
@@ -0,0 +1,97 @@
+package files
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	"charm.land/bubbles/v2/list"
+	"github.com/gohyuhan/gitti/api/git"
+)
+
+type modifiedFilesTreeNode struct {
+	directoryPath string
+	directories   map[string]*modifiedFilesTreeNode
+	files         []git.FileStatus
+}
+
+// build the list items of the modified files as a directory tree,
+// directories come before files and the children of a collapsed directory are left out
+func buildModifiedFilesTreeItems(filesStatus []git.FileStatus, collapsedDirectories map[string]bool) []list.Item {
+	root := &modifiedFilesTreeNode{directories: map[string]*modifiedFilesTreeNode{}}
+	for _, file := range filesStatus {
+		node := root
+		// an ignored directory is listed as a whole with a trailing "/", it belong to its parent directory
+		directoryPath := path.Dir(strings.TrimSuffix(file.FilePathname, "/"))
+		if directoryPath != "." {
+			for _, directoryName := range strings.Split(directoryPath, "/") {
+				child, exist := node.directories[directoryName]
+				if !exist {
+					child = &modifiedFilesTreeNode{
+						directoryPath: path.Join(node.directoryPath, directoryName),
+						directories:   map[string]*modifiedFilesTreeNode{},
+					}
+					node.directories[directoryName] = child
+				}
+				node = child
+			}
+		}
+		node.files = append(node.files, file)
+	}
+
+	items := []list.Item{}
+	appendModifiedFilesTreeNodeItems(root, 0, collapsedDirectories, &items)
+	return items
+}
+
+func appendModifiedFilesTreeNodeItems(node *modifiedFilesTreeNode, depth int, collapsedDirectories map[string]bool, items *[]list.Item) {
+	directoryNames := make([]string, 0, len(node.directories))
+	for directoryName := range node.directories {
+		directoryNames = append(directoryNames, directoryName)
+	}
+	sort.Strings(directoryNames)
+
+	for _, directoryName := range directoryNames {
+		directory := node.directories[directoryName]
+		directoryItem := GitModifiedFilesDirectoryItem{
+			DirectoryPath: directory.directoryPath,
+			Depth:         depth,
+			IsCollapsed:   collapsedDirectories[directory.directoryPath],
+		}
+		directory.aggregateState(&directoryItem)
+		*items = append(*items, directoryItem)
+
+		if !directoryItem.IsCollapsed {
+			appendModifiedFilesTreeNodeItems(directory, depth+1, collapsedDirectories, items)
+		}
+	}
+
+	sort.SliceStable(node.files, func(i, j int) bool {
+		return node.files[i].FilePathname < node.files[j].FilePathname
+	})
+	for _, file := range node.files {
+		*items = append(*items, GitModifiedFilesItem(file))
+	}
+}
+
+// aggregate the state of every file under the node into the directory item
+func (node *modifiedFilesTreeNode) aggregateState(directoryItem *GitModifiedFilesDirectoryItem) {
+	for _, file := range node.files {
+		// ignored files are only shown for inspection, they are not changes
+		if file.IndexState == "!" {
+			continue
+		}
+		if file.HasConflict {
+			directoryItem.HasConflict = true
+		}
+		if file.IndexState != " " && file.IndexState != "?" {
+			directoryItem.HasStagedChanges = true
+		}
+		if file.WorkTree != " " {
+			directoryItem.HasUnstagedChanges = true
+		}
+	}
+	for _, directory := range node.directories {
+		directory.aggregateState(directoryItem)
+	}
+}
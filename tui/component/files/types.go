@@ -3,11 +3,13 @@ package files
 import (
 	"fmt"
 	"io"
+	"path"
 	"strings"
 
 	"charm.land/bubbles/v2/list"
 	tea "charm.land/bubbletea/v2"
 
+	"github.com/gohyuhan/gitti/api/git"
 	"github.com/gohyuhan/gitti/tui/constant"
 	"github.com/gohyuhan/gitti/tui/style"
 	"github.com/gohyuhan/gitti/tui/utils"
@@ -19,12 +21,31 @@ import (
 //
 // ---------------------------------
 type (
-	GitModifiedFilesItemDelegate struct{}
-	GitModifiedFilesItem         struct {
-		FilePathname string
-		IndexState   string
-		WorkTree     string
-		HasConflict  bool
+	GitModifiedFilesItemDelegate struct {
+		IsTreeView  bool                           // render the items as a directory tree instead of full paths
+		MarkedItems map[string]bool                // the files that were marked for batch operation
+		LineChanges map[string]git.FileLineChanges // the lines added and removed of each file
+	}
+	GitModifiedFilesItem struct {
+		FilePathname     string
+		OrigPath         string
+		IndexState       string
+		WorkTree         string
+		HasConflict      bool
+		RenameScore      int
+		SubmoduleState   string
+		HeadFileMode     string
+		IndexFileMode    string
+		WorkTreeFileMode string
+	}
+	// a directory node that only exist in tree view
+	GitModifiedFilesDirectoryItem struct {
+		DirectoryPath      string
+		Depth              int
+		IsCollapsed        bool
+		HasStagedChanges   bool // at least one file under it has staged changes
+		HasUnstagedChanges bool // at least one file under it has unstaged or untracked changes
+		HasConflict        bool
 	}
 )
 
@@ -32,26 +53,83 @@ func (i GitModifiedFilesItem) FilterValue() string {
 	return i.FilePathname
 }
 
+func (i GitModifiedFilesDirectoryItem) FilterValue() string {
+	return i.DirectoryPath
+}
+
 // for list component of modified files
 func (d GitModifiedFilesItemDelegate) Height() int                             { return 1 }
 func (d GitModifiedFilesItemDelegate) Spacing() int                            { return 0 }
 func (d GitModifiedFilesItemDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
 func (d GitModifiedFilesItemDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
-	i, ok := listItem.(GitModifiedFilesItem)
-	if !ok {
-		return
-	}
-
+	var str string
 	componentWidth := m.Width() - constant.ListItemOrTitleWidthPad - 5
-	filePathName := utils.TruncateString(i.FilePathname, componentWidth)
 
-	indexState := style.StagedFileStyle.Render(i.IndexState)
-	workTree := style.UnstagedFileStyle.Render(i.WorkTree)
-	if i.IndexState == "?" {
-		indexState = style.UnstagedFileStyle.Render(i.IndexState)
-	}
+	switch i := listItem.(type) {
+	case GitModifiedFilesItem:
+		marker := " "
+		if d.MarkedItems[i.FilePathname] {
+			marker = style.MarkedItemStyle.Render("✓")
+		}
+		indexState := style.StagedFileStyle.Render(i.IndexState)
+		workTree := style.UnstagedFileStyle.Render(i.WorkTree)
+		if i.IndexState == "?" {
+			indexState = style.UnstagedFileStyle.Render(i.IndexState)
+		}
+		isIgnored := i.IndexState == "!"
+		if isIgnored {
+			indexState = style.IgnoredFileStyle.Render(i.IndexState)
+			workTree = style.IgnoredFileStyle.Render(i.WorkTree)
+		}
 
-	str := fmt.Sprintf(" %s%s %s", indexState, workTree, filePathName)
+		lineChanges, lineChangesWidth := renderFileLineChanges(d.LineChanges[i.FilePathname])
+		if d.IsTreeView {
+			// an ignored directory is listed as a whole with a trailing "/"
+			depth := strings.Count(strings.TrimSuffix(i.FilePathname, "/"), "/")
+			fileName := path.Base(i.FilePathname)
+			if strings.HasSuffix(i.FilePathname, "/") {
+				fileName += "/"
+			}
+			if i.OrigPath != "" {
+				fileName = i.OrigPath + " -> " + fileName
+			}
+			indent := strings.Repeat("  ", depth)
+			fileName = utils.TruncateString(fileName, componentWidth-len(indent)-2-lineChangesWidth)
+			if isIgnored {
+				fileName = style.IgnoredFileStyle.Render(fileName)
+			}
+			str = fmt.Sprintf("%s%s%s %s  %s%s", marker, indexState, workTree, indent, fileName, lineChanges)
+		} else {
+			filePathName := utils.TruncateString(git.FileStatus(i).DisplayPathname(), componentWidth-lineChangesWidth)
+			if isIgnored {
+				filePathName = style.IgnoredFileStyle.Render(filePathName)
+			}
+			str = fmt.Sprintf("%s%s%s %s%s", marker, indexState, workTree, filePathName, lineChanges)
+		}
+	case GitModifiedFilesDirectoryItem:
+		indexState := " "
+		workTree := " "
+		if i.HasConflict {
+			indexState = style.UnstagedFileStyle.Render("U")
+			workTree = style.UnstagedFileStyle.Render("U")
+		} else {
+			if i.HasStagedChanges {
+				indexState = style.StagedFileStyle.Render("●")
+			}
+			if i.HasUnstagedChanges {
+				workTree = style.UnstagedFileStyle.Render("●")
+			}
+		}
+		folderIcon := "▾"
+		if i.IsCollapsed {
+			folderIcon = "▸"
+		}
+		indent := strings.Repeat("  ", i.Depth)
+		directoryName := utils.TruncateString(path.Base(i.DirectoryPath)+"/", componentWidth-len(indent)-2)
+		str = fmt.Sprintf(" %s%s %s%s %s", indexState, workTree, indent, folderIcon, directoryName)
+	default:
+		return
+	}
 
 	var fn func(...string) string
 	if index == m.Index() {
@@ -66,3 +144,24 @@ func (d GitModifiedFilesItemDelegate) Render(w io.Writer, m list.Model, index in
 
 	fmt.Fprint(w, fn(str))
 }
+
+// the staged and unstaged line counts of a file, colored like the status letters (staged first), and the width it takes
+func renderFileLineChanges(lineChanges git.FileLineChanges) (string, int) {
+	var rendered strings.Builder
+	width := 0
+	if lineChanges.IsBinary {
+		rendered.WriteString(" " + style.DiffLineNumberStyle.Render("bin"))
+		width += 4
+	}
+	if lineChanges.StagedAdded > 0 || lineChanges.StagedRemoved > 0 {
+		counts := fmt.Sprintf("+%d/-%d", lineChanges.StagedAdded, lineChanges.StagedRemoved)
+		rendered.WriteString(" " + style.StagedFileStyle.Render(counts))
+		width += 1 + len(counts)
+	}
+	if lineChanges.UnstagedAdded > 0 || lineChanges.UnstagedRemoved > 0 {
+		counts := fmt.Sprintf("+%d/-%d", lineChanges.UnstagedAdded, lineChanges.UnstagedRemoved)
+		rendered.WriteString(" " + style.UnstagedFileStyle.Render(counts))
+		width += 1 + len(counts)
+	}
+	return rendered.String(), width
+}
@@ -35,7 +35,18 @@ func InitStashList(m *types.GittiModel) bool {
 	}
 	previousStashCount := len(m.CurrentRepoStashInfoList.Items())
 
-	m.CurrentRepoStashInfoList = list.New(items, GitStashItemDelegate{}, m.WindowLeftPanelWidth, m.StashComponentPanelHeight)
+	// drop the marks of the stash that no longer exist
+	existingStashHashes := make(map[string]bool, len(latestStashArray))
+	for _, stashInfo := range latestStashArray {
+		existingStashHashes[stashInfo.Hash] = true
+	}
+	for markedStashHash := range m.ListMarkedItems.StashComponent {
+		if !existingStashHashes[markedStashHash] {
+			delete(m.ListMarkedItems.StashComponent, markedStashHash)
+		}
+	}
+
+	m.CurrentRepoStashInfoList = list.New(items, GitStashItemDelegate{MarkedItems: m.ListMarkedItems.StashComponent}, m.WindowLeftPanelWidth, m.StashComponentPanelHeight)
 	m.CurrentRepoStashInfoList.SetShowPagination(false)
 	m.CurrentRepoStashInfoList.SetShowStatusBar(false)
 	m.CurrentRepoStashInfoList.SetFilteringEnabled(false)
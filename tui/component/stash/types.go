@@ -18,9 +18,12 @@ import (
 //
 // ---------------------------------
 type (
-	GitStashItemDelegate struct{}
-	GitStashItem         struct {
+	GitStashItemDelegate struct {
+		MarkedItems map[string]bool // the stash hashes that were marked for batch operation, the stash id shifts when a stash is pushed or dropped
+	}
+	GitStashItem struct {
 		Id      string
+		Hash    string
 		Message string
 	}
 )
@@ -55,6 +58,9 @@ func (d GitStashItemDelegate) Render(w io.Writer, m list.Model, index int, listI
 	}
 
 	str = utils.TruncateString(str, componentWidth)
+	if d.MarkedItems[i.Hash] {
+		str = style.MarkedItemStyle.Render("✓") + str[1:]
+	}
 
 	fmt.Fprint(w, fn(str))
 }
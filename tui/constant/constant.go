@@ -30,10 +30,33 @@ const (
 	GitDeleteBranchOutputPopUp           = "GitDeleteBranchOutputPopUp"           // IsTyping will be false
 	CreateBranchBasedOnRemotePopUp       = "CreateBranchBasedOnRemotePopUp"       // IsTyping will be true
 	CreateBranchBasedOnRemoteOutputPopUp = "CreateBranchBasedOnRemoteOutputPopUp" // IsTyping will be false
+	GitBatchOperationConfirmPromptPopUp  = "GitBatchOperationConfirmPromptPopUp"  // IsTyping will be false
+	GitBatchOperationOutputPopUp         = "GitBatchOperationOutputPopUp"         // IsTyping will be false
+	GitConflictEditorPopUp               = "GitConflictEditorPopUp"               // IsTyping will be false
+	GitRepoOperationOptionPopUp          = "GitRepoOperationOptionPopUp"          // IsTyping will be false
+	GitRepoOperationOutputPopUp          = "GitRepoOperationOutputPopUp"          // IsTyping will be false
+	GitMergeToolResultPopUp              = "GitMergeToolResultPopUp"              // IsTyping will be false
+	GitPathspecPopUp                     = "GitPathspecPopUp"                     // IsTyping will be true
+	GitPathspecActionOptionPopUp         = "GitPathspecActionOptionPopUp"         // IsTyping will be false
+	GitIgnoreRuleOptionPopUp             = "GitIgnoreRuleOptionPopUp"             // IsTyping will be false
+	GitIgnoreTargetOptionPopUp           = "GitIgnoreTargetOptionPopUp"           // IsTyping will be false
+	GitCleanPopUp                        = "GitCleanPopUp"                        // IsTyping will be false
+	GitRestoreSourcePopUp                = "GitRestoreSourcePopUp"                // IsTyping will be false
+	GitRestoreFilePopUp                  = "GitRestoreFilePopUp"                  // IsTyping will be false
+	GitRestoreTargetOptionPopUp          = "GitRestoreTargetOptionPopUp"          // IsTyping will be false
+	GitRestoreConfirmPromptPopUp         = "GitRestoreConfirmPromptPopUp"         // IsTyping will be false
+	GitCommitLogFilterPopUp              = "GitCommitLogFilterPopUp"              // IsTyping will be true
+	GitCommitLogScopeOptionPopUp         = "GitCommitLogScopeOptionPopUp"         // IsTyping will be false
+	GitCommitLogScopeBranchesPopUp       = "GitCommitLogScopeBranchesPopUp"       // IsTyping will be false
+	GitCommitParentOptionPopUp           = "GitCommitParentOptionPopUp"           // IsTyping will be false
+	GitApplyPatchErrorPopUp              = "GitApplyPatchErrorPopUp"              // IsTyping will be false
 )
 
 const SelectedLeftPanelComponentHeightRatio = 0.4
 
+// the next page of the commit log will be loaded when the selection is within this number of commits from the end
+const CommitLogNextPageThreshold = 50
+
 const (
 	MinWidth  = 80
 	MinHeight = 27
@@ -66,6 +89,26 @@ const (
 	MaxGitDeleteBranchOutputPopUpWidth           = 150
 	MaxCreateBranchBasedOnRemotePopUpWidth       = 150
 	MaxCreateBranchBasedOnRemoteOutputPopUpWidth = 150
+	MaxGitBatchOperationConfirmPromptPopUpWidth  = 150
+	MaxGitBatchOperationOutputPopUpWidth         = 150
+	MaxGitConflictEditorPopUpWidth               = 150
+	MaxGitRepoOperationOptionPopUpWidth          = 150
+	MaxGitRepoOperationOutputPopUpWidth          = 150
+	MaxGitMergeToolResultPopUpWidth              = 150
+	MaxGitPathspecPopUpWidth                     = 150
+	MaxGitPathspecActionOptionPopUpWidth         = 150
+	MaxGitIgnoreRuleOptionPopUpWidth             = 150
+	MaxGitIgnoreTargetOptionPopUpWidth           = 150
+	MaxGitCleanPopUpWidth                        = 150
+	MaxGitRestoreSourcePopUpWidth                = 150
+	MaxGitRestoreFilePopUpWidth                  = 150
+	MaxGitRestoreTargetOptionPopUpWidth          = 150
+	MaxGitRestoreConfirmPromptPopUpWidth         = 150
+	MaxGitCommitLogFilterPopUpWidth              = 150
+	MaxGitCommitLogScopeOptionPopUpWidth         = 150
+	MaxGitCommitLogScopeBranchesPopUpWidth       = 150
+	MaxGitCommitParentOptionPopUpWidth           = 150
+	MaxGitApplyPatchErrorPopUpWidth              = 150
 
 	PopUpGlobalKeyBindingViewPortHeight                = 30
 	PopUpGitCommitOutputViewPortHeight                 = 10
@@ -80,10 +123,27 @@ const (
 	PopUpChooseGitPullTypeHeight                       = 6
 	PopUpGitPullOutputViewportHeight                   = 16
 	PopUpGitDiscardTypeOptionHeight                    = 6
+	PopUpGitDiscardPartialPreviewMaxHeight             = 15
 	PopUpGitStashOperationOutputViewPortHeight         = 10
-	PopUpGitResolveConflictOptionPopUpHeight           = 6
+	PopUpGitResolveConflictOptionPopUpHeight           = 10
 	PopUpGitDeleteBranchOutputViewportHeight           = 4
 	PopUpCreateBranchBasedOnRemoteOutputViewportHeight = 4
+	PopUpGitBatchOperationConfirmItemsMaxHeight        = 10
+	PopUpGitBatchOperationOutputViewportHeight         = 12
+	PopUpGitConflictEditorViewportHeight               = 24
+	PopUpGitRepoOperationOptionPopUpHeight             = 6
+	PopUpGitRepoOperationOutputViewportHeight          = 16
+	PopUpGitPathspecPreviewMaxHeight                   = 10
+	PopUpGitPathspecActionOptionPopUpHeight            = 10
+	PopUpGitIgnoreRuleOptionPopUpHeight                = 8
+	PopUpGitIgnoreTargetOptionPopUpHeight              = 8
+	PopUpGitCleanEntryListHeight                       = 14
+	PopUpGitRestoreSourcePopUpHeight                   = 14
+	PopUpGitRestoreFilePopUpHeight                     = 14
+	PopUpGitRestoreTargetOptionPopUpHeight             = 8
+	PopUpGitCommitLogScopeOptionPopUpHeight            = 10
+	PopUpGitCommitLogScopeBranchListHeight             = 14
+	PopUpGitCommitParentOptionPopUpHeight              = 8
 )
 
 // variables for indicating which panel/components/container or whatever the hell you wanna call it that the user is currently landed or selected, so that they can do precious action related to the part of whatever the hell you wanna call it
@@ -111,6 +171,9 @@ var ComponentNavigationList = []string{
 
 const DETAIL_COMPONENT_PANEL_UPDATED = "DETAIL_COMPONENT_PANEL_UPDATED"
 
+// the commit log pages were loaded up to the commit being jumped to
+const COMMIT_LOG_JUMP_LOADED = "COMMIT_LOG_JUMP_LOADED"
+
 const (
 	AUTHOR_GITHUB   = "https://github.com/gohyuhan"
 	AUTHOR_LINKEDIN = "https://my.linkedin.com/in/yu-han-goh-209480200"
@@ -126,3 +189,24 @@ const (
 	PUSHACTION                = "PUSHACTION"
 	CREATEBRANCHBASEDONREMOTE = "CREATEBRANCHBASEDONREMOTE"
 )
+
+// batch operation on the marked items of a list component
+const (
+	BATCHDISCARDFILES   = "BATCHDISCARDFILES"
+	BATCHSTASHFILES     = "BATCHSTASHFILES"
+	BATCHDELETEBRANCHES = "BATCHDELETEBRANCHES"
+	BATCHDROPSTASHES    = "BATCHDROPSTASHES"
+	BATCHCLEANFILES     = "BATCHCLEANFILES" // the checked entries of the clean pop up, shares the batch output pop up
+)
+
+// action on the files matched by a pathspec, discard and stash will go through the batch operation
+const (
+	PATHSPECSTAGEFILES   = "PATHSPECSTAGEFILES"
+	PATHSPECUNSTAGEFILES = "PATHSPECUNSTAGEFILES"
+)
+
+// not git resolve types, the conflict will be resolved outside of the resolve option list
+const (
+	CONFLICTEDITOR    = "CONFLICTEDITOR"    // the conflict editor will be opened to resolve the conflict block by block
+	CONFLICTMERGETOOL = "CONFLICTMERGETOOL" // the merge tool configured in git config will be launched
+)
@@ -5,6 +5,8 @@ import (
 	"github.com/gohyuhan/gitti/tui/constant"
 	branchPopUp "github.com/gohyuhan/gitti/tui/popup/branch"
 	commitPopUp "github.com/gohyuhan/gitti/tui/popup/commit"
+	logFilterPopUp "github.com/gohyuhan/gitti/tui/popup/logfilter"
+	pathspecPopUp "github.com/gohyuhan/gitti/tui/popup/pathspec"
 	remotePopUp "github.com/gohyuhan/gitti/tui/popup/remote"
 	stashPopUp "github.com/gohyuhan/gitti/tui/popup/stash"
 	"github.com/gohyuhan/gitti/tui/types"
@@ -102,6 +104,23 @@ func HandleTypingKeyBindingInteraction(msg tea.KeyMsg, m *types.GittiModel) (*ty
 			popUp.RemoteBranchNameInput, cmd = popUp.RemoteBranchNameInput.Update(msg)
 			return m, cmd
 		}
+	case constant.GitPathspecPopUp:
+		popUp, ok := m.PopUpModel.(*pathspecPopUp.GitPathspecPopUpModel)
+		if ok {
+			var cmd tea.Cmd
+			popUp.PathspecInput, cmd = popUp.PathspecInput.Update(msg)
+			// preview the matched files as the pattern is typed
+			pathspecPopUp.UpdateGitPathspecPreview(m)
+			return m, cmd
+		}
+	case constant.GitCommitLogFilterPopUp:
+		popUp, ok := m.PopUpModel.(*logFilterPopUp.GitCommitLogFilterPopUpModel)
+		if ok {
+			var cmd tea.Cmd
+			inputIndex := popUp.CurrentActiveInputIndex - 1
+			popUp.FilterInputs[inputIndex], cmd = popUp.FilterInputs[inputIndex].Update(msg)
+			return m, cmd
+		}
 
 	}
 	return m, nil
@@ -127,18 +146,51 @@ func HandleNonTypingGlobalKeyBindingInteraction(msg tea.KeyMsg, m *types.GittiMo
 	case "A":
 		return handleNonTypingaKeyBindingInteraction(m)
 
+	case "b":
+		return handleNonTypingbKeyBindingInteraction(m)
+
 	case "c":
 		return handleNonTypingcKeyBindingInteraction(m)
 
+	case "C":
+		return handleNonTypingCKeyBindingInteraction(m)
+
 	case "d":
 		return handleNonTypingdKeyBindingInteraction(m)
 
+	case "D":
+		return handleNonTypingDKeyBindingInteraction(m)
+
 	case "e":
 		return handleNonTypingeKeyBindingInteraction(m)
 
+	case "f":
+		return handleNonTypingfKeyBindingInteraction(m)
+
+	case "g":
+		return handleNonTypinggKeyBindingInteraction(m)
+
+	case "G":
+		return handleNonTypingGKeyBindingInteraction(m)
+
+	case "i":
+		return handleNonTypingiKeyBindingInteraction(m)
+
+	case "I":
+		return handleNonTypingIKeyBindingInteraction(m)
+
+	case "m":
+		return handleNonTypingmKeyBindingInteraction(m)
+
+	case "M":
+		return handleNonTypingMKeyBindingInteraction(m)
+
 	case "n":
 		return handleNonTypingnKeyBindingInteraction(m)
 
+	case "o":
+		return handleNonTypingoKeyBindingInteraction(m)
+
 	case "p":
 		return handleNonTypingpKeyBindingInteraction(m)
 
@@ -148,18 +200,54 @@ func HandleNonTypingGlobalKeyBindingInteraction(msg tea.KeyMsg, m *types.GittiMo
 	case "r":
 		return handleNonTypingrKeyBindingInteraction(m)
 
+	case "R":
+		return handleNonTypingRKeyBindingInteraction(m)
+
 	case "s":
 		return handleNonTypingsKeyBindingInteraction(m)
 
 	case "S":
 		return handleNonTypingSKeyBindingInteraction(m)
 
+	case "t":
+		return handleNonTypingtKeyBindingInteraction(m)
+
+	case "T":
+		return handleNonTypingTKeyBindingInteraction(m)
+
+	case "v":
+		return handleNonTypingvKeyBindingInteraction(m)
+
+	case "|":
+		return handleNonTypingVerticalBarKeyBindingInteraction(m)
+
+	case "w":
+		return handleNonTypingwKeyBindingInteraction(m)
+
+	case "x":
+		return handleNonTypingxKeyBindingInteraction(m)
+
+	case "{":
+		return handleNonTypingLeftBraceKeyBindingInteraction(m)
+
+	case "}":
+		return handleNonTypingRightBraceKeyBindingInteraction(m)
+
+	case "<":
+		return handleNonTypingLessThanKeyBindingInteraction(m)
+
+	case ">":
+		return handleNonTypingGreaterThanKeyBindingInteraction(m)
+
 	case "[":
 		return handleNonTypingLeftBracketKeyBindingInteraction(m)
 
 	case "]":
 		return handleNonTypingRightBracketKeyBindingInteraction(m)
 
+	case "/":
+		return handleNonTypingSlashKeyBindingInteraction(m)
+
 	case "q", "Q":
 		// only work when there is no pop up
 		return handleNonTypingqQKeyBindingInteraction(m)
@@ -1,22 +1,35 @@
 package handler
 
 import (
+	"slices"
+
 	tea "charm.land/bubbletea/v2"
 	"github.com/gohyuhan/gitti/api"
 	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/settings"
 	"github.com/gohyuhan/gitti/tui/component/branch"
+	"github.com/gohyuhan/gitti/tui/component/commitlog"
 	"github.com/gohyuhan/gitti/tui/component/files"
 	"github.com/gohyuhan/gitti/tui/component/stash"
 	"github.com/gohyuhan/gitti/tui/constant"
 	"github.com/gohyuhan/gitti/tui/layout"
+	batchPopUp "github.com/gohyuhan/gitti/tui/popup/batch"
 	branchPopUp "github.com/gohyuhan/gitti/tui/popup/branch"
+	cleanPopUp "github.com/gohyuhan/gitti/tui/popup/clean"
 	commitPopUp "github.com/gohyuhan/gitti/tui/popup/commit"
+	commitParentPopUp "github.com/gohyuhan/gitti/tui/popup/commitparent"
 	discardPopUp "github.com/gohyuhan/gitti/tui/popup/discard"
+	ignorePopUp "github.com/gohyuhan/gitti/tui/popup/ignore"
 	keybindingPopUp "github.com/gohyuhan/gitti/tui/popup/keybinding"
+	logFilterPopUp "github.com/gohyuhan/gitti/tui/popup/logfilter"
+	logScopePopUp "github.com/gohyuhan/gitti/tui/popup/logscope"
+	operationPopUp "github.com/gohyuhan/gitti/tui/popup/operation"
+	pathspecPopUp "github.com/gohyuhan/gitti/tui/popup/pathspec"
 	pullPopUp "github.com/gohyuhan/gitti/tui/popup/pull"
 	pushPopUp "github.com/gohyuhan/gitti/tui/popup/push"
 	remotePopUp "github.com/gohyuhan/gitti/tui/popup/remote"
 	resolvePopUp "github.com/gohyuhan/gitti/tui/popup/resolve"
+	restorePopUp "github.com/gohyuhan/gitti/tui/popup/restore"
 	stashPopUp "github.com/gohyuhan/gitti/tui/popup/stash"
 	"github.com/gohyuhan/gitti/tui/services"
 	"github.com/gohyuhan/gitti/tui/types"
@@ -39,6 +52,8 @@ func handleNonTyping1KeyBindingInteraction(m *types.GittiModel) (*types.GittiMod
 			layout.LeftPanelDynamicResize(m)
 			services.FetchDetailComponentPanelInfoService(m, true)
 		}
+	} else if m.PopUpType == constant.GitConflictEditorPopUp {
+		resolvePopUp.ResolveGitConflictEditorBlock(m, git.CONFLICTBLOCKOURS)
 	}
 	return m, nil
 }
@@ -51,6 +66,8 @@ func handleNonTyping2KeyBindingInteraction(m *types.GittiModel) (*types.GittiMod
 			layout.LeftPanelDynamicResize(m)
 			services.FetchDetailComponentPanelInfoService(m, true)
 		}
+	} else if m.PopUpType == constant.GitConflictEditorPopUp {
+		resolvePopUp.ResolveGitConflictEditorBlock(m, git.CONFLICTBLOCKTHEIRS)
 	}
 	return m, nil
 }
@@ -63,6 +80,8 @@ func handleNonTyping3KeyBindingInteraction(m *types.GittiModel) (*types.GittiMod
 			layout.LeftPanelDynamicResize(m)
 			services.FetchDetailComponentPanelInfoService(m, true)
 		}
+	} else if m.PopUpType == constant.GitConflictEditorPopUp {
+		resolvePopUp.ResolveGitConflictEditorBlock(m, git.CONFLICTBLOCKBOTH)
 	}
 	return m, nil
 }
@@ -75,6 +94,8 @@ func handleNonTyping4KeyBindingInteraction(m *types.GittiModel) (*types.GittiMod
 			layout.LeftPanelDynamicResize(m)
 			services.FetchDetailComponentPanelInfoService(m, true)
 		}
+	} else if m.PopUpType == constant.GitConflictEditorPopUp {
+		resolvePopUp.ResolveGitConflictEditorBlock(m, git.CONFLICTBLOCKBASE)
 	}
 	return m, nil
 }
@@ -88,6 +109,25 @@ func handleNonTypingaKeyBindingInteraction(m *types.GittiModel) (*types.GittiMod
 		commitPopUp.InitGitAmendCommitPopUpModel(m)
 
 		m.IsTyping.Store(true)
+	} else if m.PopUpType == constant.GitCleanPopUp {
+		cleanPopUp.ToggleAllGitCleanEntries(m)
+	}
+	return m, nil
+}
+
+// handleNonTypingbKeyBindingInteraction handles the 'b' key to toggle the blame of the selected modified file in detail panel
+func handleNonTypingbKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if !m.ShowPopUp.Load() {
+		isModifiedFilesDetail := (m.CurrentSelectedComponent == constant.DetailComponent || m.CurrentSelectedComponent == constant.DetailComponentTwo) &&
+			m.DetailPanelParentComponent == constant.ModifiedFilesComponent
+		if m.CurrentSelectedComponent == constant.ModifiedFilesComponent || isModifiedFilesDetail {
+			m.IsBlameView = !m.IsBlameView
+			// the blame is only shown on the first detail component panel
+			if m.CurrentSelectedComponent == constant.DetailComponentTwo {
+				m.CurrentSelectedComponent = constant.DetailComponent
+			}
+			services.FetchDetailComponentPanelInfoService(m, true)
+		}
 	}
 	return m, nil
 }
@@ -109,8 +149,29 @@ func handleNonTypingcKeyBindingInteraction(m *types.GittiModel) (*types.GittiMod
 	return m, nil
 }
 
+// handleNonTypingCKeyBindingInteraction handles the 'C' key to preview what git clean would remove and choose what to remove
+func handleNonTypingCKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if !m.ShowPopUp.Load() {
+		m.PopUpType = constant.GitCleanPopUp
+		cleanPopUp.InitGitCleanPopUpModel(m)
+		m.ShowPopUp.Store(true)
+		m.IsTyping.Store(false)
+	}
+	return m, nil
+}
+
 func handleNonTypingdKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
 	if !m.ShowPopUp.Load() {
+		// run on all the marked items instead of the selected one when there are any
+		switch {
+		case m.CurrentSelectedComponent == constant.LocalBranchComponent && len(m.ListMarkedItems.LocalBranchComponent) > 0:
+			return initGitBatchOperationConfirmPromptPopUp(m, constant.BATCHDELETEBRANCHES)
+		case m.CurrentSelectedComponent == constant.ModifiedFilesComponent && len(m.ListMarkedItems.ModifiedFilesComponent) > 0:
+			return initGitBatchOperationConfirmPromptPopUp(m, constant.BATCHDISCARDFILES)
+		case m.CurrentSelectedComponent == constant.StashComponent && len(m.ListMarkedItems.StashComponent) > 0:
+			return initGitBatchOperationConfirmPromptPopUp(m, constant.BATCHDROPSTASHES)
+		}
+
 		switch m.CurrentSelectedComponent {
 		case constant.LocalBranchComponent:
 			selectedBranchItem := m.CurrentRepoBranchesInfoList.SelectedItem()
@@ -135,10 +196,21 @@ func handleNonTypingdKeyBindingInteraction(m *types.GittiModel) (*types.GittiMod
 			}
 		case constant.ModifiedFilesComponent:
 			currentSelectedFileItem := m.CurrentRepoModifiedFilesInfoList.SelectedItem()
-			if currentSelectedFileItem != nil {
-				currentSelectedFile := currentSelectedFileItem.(files.GitModifiedFilesItem)
+			if currentSelectedDirectory, ok := currentSelectedFileItem.(files.GitModifiedFilesDirectoryItem); ok {
+				// discard everything under the directory, not allowed when any file under it has conflict
+				if currentSelectedDirectory.HasConflict {
+					return m, nil
+				}
+				m.ShowPopUp.Store(true)
+				m.IsTyping.Store(false)
+				m.PopUpType = constant.GitDiscardConfirmPromptPopUp
+				discardPopUp.InitGitDiscardConfirmPromptPopupModel(m, currentSelectedDirectory.DirectoryPath, git.DISCARDDIRECTORY)
+				return m, nil
+			}
+			if currentSelectedFile, ok := currentSelectedFileItem.(files.GitModifiedFilesItem); ok {
 				// return early if the file has conflict (we should not allow discard on conflict files but resolve option instead)
-				if currentSelectedFile.HasConflict {
+				// or is ignored (only shown for inspection)
+				if currentSelectedFile.HasConflict || currentSelectedFile.IndexState == "!" {
 					return m, nil
 				}
 				m.ShowPopUp.Store(true)
@@ -175,7 +247,18 @@ func handleNonTypingdKeyBindingInteraction(m *types.GittiModel) (*types.GittiMod
 					discardPopUp.InitGitDiscardConfirmPromptPopupModel(m, currentSelectedFile.FilePathname, git.DISCARDWHOLE)
 				}
 			}
+		case constant.DetailComponent, constant.DetailComponentTwo:
+			// discard only the hunk/line under the diff cursor
+			if m.DetailPanelParentComponent == constant.ModifiedFilesComponent {
+				if services.InitGitDiscardPartialConfirmPromptPopUpFromDiffCursor(m) {
+					m.PopUpType = constant.GitDiscardConfirmPromptPopUp
+					m.ShowPopUp.Store(true)
+					m.IsTyping.Store(false)
+				}
+			}
 		}
+	} else if m.PopUpType == constant.GitCleanPopUp {
+		cleanPopUp.ToggleGitCleanDirectories(m)
 	}
 	return m, nil
 }
@@ -184,8 +267,7 @@ func handleNonTypingeKeyBindingInteraction(m *types.GittiModel) (*types.GittiMod
 	if m.CurrentSelectedComponent == constant.ModifiedFilesComponent {
 
 		currentSelectedFileItem := m.CurrentRepoModifiedFilesInfoList.SelectedItem()
-		if currentSelectedFileItem != nil {
-			currentSelectedFile := currentSelectedFileItem.(files.GitModifiedFilesItem)
+		if currentSelectedFile, ok := currentSelectedFileItem.(files.GitModifiedFilesItem); ok {
 			cmd, isNonEditorEditor := utils.ReturnEditorLaunchCommand(currentSelectedFile.FilePathname, m.UserSetEditor)
 			if isNonEditorEditor {
 				cmd.Start()
@@ -202,6 +284,90 @@ func handleNonTypingeKeyBindingInteraction(m *types.GittiModel) (*types.GittiMod
 	return m, nil
 }
 
+// handleNonTypingfKeyBindingInteraction handles the 'f' key to restore a file as of a branch, tag or commit,
+// the selected modified file from the files panel or a file changed by the selected commit from the commit log
+func handleNonTypingfKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if m.ShowPopUp.Load() {
+		return m, nil
+	}
+	switch m.CurrentSelectedComponent {
+	case constant.ModifiedFilesComponent:
+		currentSelectedFile, ok := m.CurrentRepoModifiedFilesInfoList.SelectedItem().(files.GitModifiedFilesItem)
+		// a conflict should be resolved instead and an ignored file has no history to restore from
+		if !ok || currentSelectedFile.HasConflict || currentSelectedFile.IndexState == "!" {
+			return m, nil
+		}
+		m.PopUpType = constant.GitRestoreSourcePopUp
+		restorePopUp.InitGitRestoreSourcePopUpModel(m, currentSelectedFile.FilePathname)
+		m.ShowPopUp.Store(true)
+		m.IsTyping.Store(false)
+	case constant.CommitLogComponent, constant.DetailComponent:
+		if m.CurrentSelectedComponent == constant.DetailComponent && m.DetailPanelParentComponent != constant.CommitLogComponent {
+			return m, nil
+		}
+		currentSelectedCommit, ok := m.CurrentRepoCommitLogInfoList.SelectedItem().(commitlog.GitCommitLogItem)
+		if !ok {
+			return m, nil
+		}
+		filePathNames := m.GitOperations.GitFiles.FilesChangedInCommit(currentSelectedCommit.Hash)
+		if len(filePathNames) < 1 {
+			return m, nil
+		}
+		m.PopUpType = constant.GitRestoreFilePopUp
+		restorePopUp.InitGitRestoreFilePopUpModel(m, currentSelectedCommit.Hash, filePathNames)
+		m.ShowPopUp.Store(true)
+		m.IsTyping.Store(false)
+	}
+	return m, nil
+}
+
+// handleNonTypinggKeyBindingInteraction handles the 'g' key to stage, unstage, discard or stash the modified files matching a pathspec
+func handleNonTypinggKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if !m.ShowPopUp.Load() && m.CurrentSelectedComponent == constant.ModifiedFilesComponent {
+		if len(m.GitOperations.GitFiles.FilesStatus()) < 1 {
+			return m, nil
+		}
+		m.PopUpType = constant.GitPathspecPopUp
+		pathspecPopUp.InitGitPathspecPopUpModel(m)
+		m.ShowPopUp.Store(true)
+		m.IsTyping.Store(true)
+	}
+	return m, nil
+}
+
+// handleNonTypingGKeyBindingInteraction handles the 'G' key to choose the history that the commit log graph is drawn for
+func handleNonTypingGKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if !m.ShowPopUp.Load() && m.CurrentSelectedComponent == constant.CommitLogComponent && !m.IsCommitLogFilesView {
+		m.PopUpType = constant.GitCommitLogScopeOptionPopUp
+		logScopePopUp.InitGitCommitLogScopeOptionPopUpModel(m)
+		m.ShowPopUp.Store(true)
+		m.IsTyping.Store(false)
+	}
+	return m, nil
+}
+
+// handleNonTypingiKeyBindingInteraction handles the 'i' key to ignore the selected untracked file
+func handleNonTypingiKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if !m.ShowPopUp.Load() && m.CurrentSelectedComponent == constant.ModifiedFilesComponent {
+		currentSelectedFileItem := m.CurrentRepoModifiedFilesInfoList.SelectedItem()
+		if currentSelectedFile, ok := currentSelectedFileItem.(files.GitModifiedFilesItem); ok && currentSelectedFile.IndexState == "?" {
+			m.PopUpType = constant.GitIgnoreRuleOptionPopUp
+			ignorePopUp.InitGitIgnoreRuleOptionPopUpModel(m, currentSelectedFile.FilePathname)
+			m.ShowPopUp.Store(true)
+			m.IsTyping.Store(false)
+		}
+	}
+	return m, nil
+}
+
+// handleNonTypingIKeyBindingInteraction handles the 'I' key to show or hide the ignored files in the modified files panel
+func handleNonTypingIKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if !m.ShowPopUp.Load() && m.CurrentSelectedComponent == constant.ModifiedFilesComponent {
+		services.GitToggleShowIgnoredFilesService(m)
+	}
+	return m, nil
+}
+
 func handleNonTypingnKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
 	if !m.ShowPopUp.Load() {
 		if m.CurrentSelectedComponent == constant.LocalBranchComponent {
@@ -216,6 +382,17 @@ func handleNonTypingnKeyBindingInteraction(m *types.GittiModel) (*types.GittiMod
 	return m, nil
 }
 
+// handleNonTypingoKeyBindingInteraction handles the 'o' key to continue, skip or abort the operation that the repo is in the middle of
+func handleNonTypingoKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if !m.ShowPopUp.Load() && m.RepoOperationState.Operation != git.REPOOPERATIONNONE {
+		m.PopUpType = constant.GitRepoOperationOptionPopUp
+		operationPopUp.InitGitRepoOperationOptionPopUpModel(m, m.RepoOperationState.Operation)
+		m.ShowPopUp.Store(true)
+		m.IsTyping.Store(false)
+	}
+	return m, nil
+}
+
 func handleNonTypingpKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
 	if !m.ShowPopUp.Load() {
 		// first we need to check if there are any push/pull origin origin for this repo
@@ -279,8 +456,7 @@ func handleNonTypingrKeyBindingInteraction(m *types.GittiModel) (*types.GittiMod
 		switch m.CurrentSelectedComponent {
 		case constant.ModifiedFilesComponent:
 			currentSelectedFileItem := m.CurrentRepoModifiedFilesInfoList.SelectedItem()
-			if currentSelectedFileItem != nil {
-				currentSelectedFile := currentSelectedFileItem.(files.GitModifiedFilesItem)
+			if currentSelectedFile, ok := currentSelectedFileItem.(files.GitModifiedFilesItem); ok {
 				// return early if the file has no conflict
 				if !currentSelectedFile.HasConflict {
 					return m, nil
@@ -297,12 +473,14 @@ func handleNonTypingrKeyBindingInteraction(m *types.GittiModel) (*types.GittiMod
 
 func handleNonTypingsKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
 	if m.CurrentSelectedComponent == constant.ModifiedFilesComponent {
+		if len(m.ListMarkedItems.ModifiedFilesComponent) > 0 {
+			return initGitBatchOperationConfirmPromptPopUp(m, constant.BATCHSTASHFILES)
+		}
 		currentSelectedModifiedFile := m.CurrentRepoModifiedFilesInfoList.SelectedItem()
 		var filePathName string
-		if currentSelectedModifiedFile != nil {
-			selectedFile := currentSelectedModifiedFile.(files.GitModifiedFilesItem)
-			// return early if the file is in a conflict status
-			if selectedFile.HasConflict {
+		if selectedFile, ok := currentSelectedModifiedFile.(files.GitModifiedFilesItem); ok {
+			// return early if the file is in a conflict status or ignored
+			if selectedFile.HasConflict || selectedFile.IndexState == "!" {
 				return m, nil
 			}
 			filePathName = selectedFile.FilePathname
@@ -320,7 +498,7 @@ func handleNonTypingSKeyBindingInteraction(m *types.GittiModel) (*types.GittiMod
 		currentSelectedModifiedFile := m.CurrentRepoModifiedFilesInfoList.SelectedItem()
 		var filePathName string
 		if currentSelectedModifiedFile != nil {
-			filePathName = currentSelectedModifiedFile.(files.GitModifiedFilesItem).FilePathname
+			filePathName = currentSelectedModifiedFile.FilterValue()
 			m.PopUpType = constant.GitStashMessagePopUp
 			stashPopUp.InitGitStashMessagePopUpModel(m, filePathName, git.STASHALL)
 			m.ShowPopUp.Store(true)
@@ -330,6 +508,116 @@ func handleNonTypingSKeyBindingInteraction(m *types.GittiModel) (*types.GittiMod
 	return m, nil
 }
 
+// cycle the algorithm used to generate the diff
+func handleNonTypingDKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if !m.ShowPopUp.Load() && isDiffComponentSelected(m) {
+		switch m.DiffOptions.Algorithm {
+		case git.DIFFALGORITHMMYERS:
+			m.DiffOptions.Algorithm = git.DIFFALGORITHMPATIENCE
+		case git.DIFFALGORITHMPATIENCE:
+			m.DiffOptions.Algorithm = git.DIFFALGORITHMHISTOGRAM
+		default:
+			m.DiffOptions.Algorithm = git.DIFFALGORITHMMYERS
+		}
+		services.FetchDetailComponentPanelInfoService(m, true)
+	}
+	return m, nil
+}
+
+// toggle the rename detection of the diff
+func handleNonTypingRKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if !m.ShowPopUp.Load() && isDiffComponentSelected(m) {
+		m.DiffOptions.DetectRenames = !m.DiffOptions.DetectRenames
+		// a renamed file is listed as a deleted and an added file without the rename detection
+		if m.IsCommitLogFilesView {
+			commitlog.InitGitCommitLogFilesList(m)
+		}
+		services.FetchDetailComponentPanelInfoService(m, true)
+	}
+	return m, nil
+}
+
+func handleNonTypingmKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if !m.ShowPopUp.Load() {
+		// mark or unmark the selected item for batch operation
+		switch m.CurrentSelectedComponent {
+		case constant.LocalBranchComponent:
+			// the checked out branch can't be deleted, so it can't be marked either
+			if selectedBranch, ok := m.CurrentRepoBranchesInfoList.SelectedItem().(branch.GitBranchItem); ok && !selectedBranch.IsCheckedOut {
+				toggleListItemMark(m.ListMarkedItems.LocalBranchComponent, selectedBranch.BranchName)
+			}
+		case constant.ModifiedFilesComponent:
+			// ignored files are only shown for inspection, they can't be part of a batch operation
+			if selectedFile, ok := m.CurrentRepoModifiedFilesInfoList.SelectedItem().(files.GitModifiedFilesItem); ok && selectedFile.IndexState != "!" {
+				toggleListItemMark(m.ListMarkedItems.ModifiedFilesComponent, selectedFile.FilePathname)
+			}
+		case constant.StashComponent:
+			if selectedStash, ok := m.CurrentRepoStashInfoList.SelectedItem().(stash.GitStashItem); ok {
+				toggleListItemMark(m.ListMarkedItems.StashComponent, selectedStash.Hash)
+			}
+		}
+	}
+	return m, nil
+}
+
+func handleNonTypingMKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if !m.ShowPopUp.Load() {
+		// clear all the marks of the current list
+		switch m.CurrentSelectedComponent {
+		case constant.LocalBranchComponent:
+			clear(m.ListMarkedItems.LocalBranchComponent)
+		case constant.ModifiedFilesComponent:
+			clear(m.ListMarkedItems.ModifiedFilesComponent)
+		case constant.StashComponent:
+			clear(m.ListMarkedItems.StashComponent)
+		}
+	}
+	return m, nil
+}
+
+func handleNonTypingtKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if !m.ShowPopUp.Load() {
+		if m.CurrentSelectedComponent == constant.ModifiedFilesComponent {
+			// switch the modified files between flat list and directory tree
+			m.IsModifiedFilesTreeView = !m.IsModifiedFilesTreeView
+			needReinit := files.InitModifiedFilesList(m)
+			services.FetchDetailComponentPanelInfoService(m, needReinit)
+		}
+	}
+	return m, nil
+}
+
+// handleNonTypingTKeyBindingInteraction handles the 'T' key to open the selected modified file in the merge tool (conflicted file) or diff tool
+func handleNonTypingTKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if !m.ShowPopUp.Load() && m.CurrentSelectedComponent == constant.ModifiedFilesComponent {
+		currentSelectedFileItem := m.CurrentRepoModifiedFilesInfoList.SelectedItem()
+		if currentSelectedFile, ok := currentSelectedFileItem.(files.GitModifiedFilesItem); ok {
+			fileStatus, exist := m.GitOperations.GitFiles.FileStatusOf(currentSelectedFile.FilePathname)
+			if !exist {
+				return m, nil
+			}
+			if fileStatus.HasConflict {
+				return m, services.LaunchGitMergeToolService(m, fileStatus.FilePathname)
+			}
+			return m, services.LaunchGitDiffToolService(m, fileStatus)
+		}
+	}
+	return m, nil
+}
+
+func handleNonTypingvKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if !m.ShowPopUp.Load() {
+		switch m.CurrentSelectedComponent {
+		case constant.DetailComponent, constant.DetailComponentTwo:
+			// switch the diff cursor between hunk and line mode
+			if m.DetailPanelParentComponent == constant.ModifiedFilesComponent {
+				services.ToggleDetailPanelDiffCursorMode(m)
+			}
+		}
+	}
+	return m, nil
+}
+
 func handleNonTypingqQKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
 	if !m.ShowPopUp.Load() {
 		if api.GITDAEMON != nil {
@@ -349,6 +637,8 @@ func handleNonTypingBackspaceKeyBindingInteraction(m *types.GittiModel) (*types.
 			m.ShowPopUp.Store(true)
 			m.IsTyping.Store(false)
 		}
+	} else if m.ShowPopUp.Load() && m.PopUpType == constant.GitConflictEditorPopUp {
+		resolvePopUp.ResolveGitConflictEditorBlock(m, git.CONFLICTBLOCKUNRESOLVED)
 	}
 	return m, nil
 }
@@ -357,15 +647,45 @@ func handleNonTypingEnterKeyBindingInteraction(m *types.GittiModel) (*types.Gitt
 	if !m.ShowPopUp.Load() {
 		switch m.CurrentSelectedComponent {
 		case constant.ModifiedFilesComponent:
+			// expand or collapse the directory in tree view instead of going into the detail panel
+			if currentSelectedDirectory, ok := m.CurrentRepoModifiedFilesInfoList.SelectedItem().(files.GitModifiedFilesDirectoryItem); ok {
+				m.ModifiedFilesCollapsedDirectories[currentSelectedDirectory.DirectoryPath] = !currentSelectedDirectory.IsCollapsed
+				needReinit := files.InitModifiedFilesList(m)
+				services.FetchDetailComponentPanelInfoService(m, needReinit)
+				return m, nil
+			}
 			if len(m.CurrentRepoModifiedFilesInfoList.Items()) > 0 {
 				m.CurrentSelectedComponent = constant.DetailComponent
 				m.DetailPanelParentComponent = constant.ModifiedFilesComponent
+				services.RefreshDetailPanelDiffCursorContent(m)
 			}
 		case constant.CommitLogComponent:
-			if len(m.CurrentRepoCommitLogInfoList.Items()) > 0 {
-				m.CurrentSelectedComponent = constant.DetailComponent
-				m.DetailPanelParentComponent = constant.CommitLogComponent
+			if m.IsCommitLogFilesView {
+				if len(m.CurrentRepoCommitLogFilesInfoList.Items()) > 0 {
+					m.CurrentSelectedComponent = constant.DetailComponent
+					m.DetailPanelParentComponent = constant.CommitLogComponent
+				}
+				return m, nil
 			}
+			currentSelectedCommit, ok := m.CurrentRepoCommitLogInfoList.SelectedItem().(commitlog.GitCommitLogItem)
+			if ok {
+				// a merge commit can be diffed against any of its parents, so the parent is to be chosen first
+				if len(currentSelectedCommit.Parents) > 1 {
+					m.PopUpType = constant.GitCommitParentOptionPopUp
+					commitParentPopUp.InitGitCommitParentOptionPopUpModel(m, currentSelectedCommit.Hash)
+					m.ShowPopUp.Store(true)
+					m.IsTyping.Store(false)
+					return m, nil
+				}
+				parentHash := ""
+				if len(currentSelectedCommit.Parents) == 1 {
+					parentHash = currentSelectedCommit.Parents[0]
+				}
+				services.GitCommitLogFilesViewService(m, currentSelectedCommit.Hash, parentHash, 0)
+			}
+		case constant.DetailComponent:
+			// on blame, go to the commit that last touched the line under the cursor
+			services.JumpToDetailPanelBlameCursorCommit(m)
 		case constant.StashComponent:
 			if len(m.CurrentRepoStashInfoList.Items()) > 0 {
 				m.CurrentSelectedComponent = constant.DetailComponent
@@ -500,11 +820,19 @@ func handleNonTypingEnterKeyBindingInteraction(m *types.GittiModel) (*types.Gitt
 		case constant.GitDiscardConfirmPromptPopUp:
 			popUp, ok := m.PopUpModel.(*discardPopUp.GitDiscardConfirmPromptPopUpModel)
 			if ok {
-				services.GitDiscardFileChangesService(m, popUp.FilePathName, popUp.DiscardType)
+				var cmd tea.Cmd
+				if popUp.DiscardType == git.DISCARDPARTIAL {
+					cmd = services.GitDiscardPartialFileChangesService(m, popUp.FileDiff, popUp.SelectedLines, popUp.IncludeIndex)
+				} else if popUp.DiscardType == git.DISCARDDIRECTORY {
+					services.GitDiscardDirectoryChangesService(m, popUp.FilePathName)
+				} else {
+					services.GitDiscardFileChangesService(m, popUp.FilePathName, popUp.DiscardType)
+				}
 				m.ShowPopUp.Store(false)
 				m.IsTyping.Store(false)
 				m.PopUpType = constant.NoPopUp
 				m.PopUpModel = nil
+				return m, cmd
 			}
 		case constant.GitStashConfirmPromptPopUp:
 			popUp, ok := m.PopUpModel.(*stashPopUp.GitStashConfirmPromptPopUpModel)
@@ -523,9 +851,252 @@ func handleNonTypingEnterKeyBindingInteraction(m *types.GittiModel) (*types.Gitt
 			popUp, ok := m.PopUpModel.(*resolvePopUp.GitResolveConflictOptionPopUpModel)
 			if ok {
 				selectedResolveType := popUp.ResolveConflictOptionList.SelectedItem().(resolvePopUp.GitResolveConflictOptionItem)
-				services.GitResolveConflictService(m, popUp.FilePathName, selectedResolveType.ResolveType)
+				if selectedResolveType.ResolveType == constant.CONFLICTMERGETOOL {
+					m.ShowPopUp.Store(false)
+					m.IsTyping.Store(false)
+					m.PopUpType = constant.NoPopUp
+					m.PopUpModel = nil
+					return m, services.LaunchGitMergeToolService(m, popUp.FilePathName)
+				} else if selectedResolveType.ResolveType == constant.CONFLICTEDITOR {
+					conflictFile, ok := m.GitOperations.GitFiles.GetConflictFile(popUp.FilePathName)
+					if ok && len(conflictFile.Blocks()) > 0 {
+						m.PopUpType = constant.GitConflictEditorPopUp
+						resolvePopUp.InitGitConflictEditorPopUpModel(m, popUp.FilePathName, conflictFile)
+					}
+					// stay on the resolve options when there is no conflict block to be resolved within the editor
+					return m, nil
+				} else {
+					services.GitResolveConflictService(m, popUp.FilePathName, selectedResolveType.ResolveType)
+				}
+				m.ShowPopUp.Store(false)
+				m.IsTyping.Store(false)
+			}
+		case constant.GitConflictEditorPopUp:
+			popUp, ok := m.PopUpModel.(*resolvePopUp.GitConflictEditorPopUpModel)
+			if ok {
+				services.GitWriteConflictResolutionService(m, popUp.FilePathName, popUp.ConflictFile)
+				m.ShowPopUp.Store(false)
+				m.IsTyping.Store(false)
+				m.PopUpType = constant.NoPopUp
+				m.PopUpModel = nil
+			}
+		case constant.GitMergeToolResultPopUp, constant.GitApplyPatchErrorPopUp:
+			m.ShowPopUp.Store(false)
+			m.IsTyping.Store(false)
+			m.PopUpType = constant.NoPopUp
+			m.PopUpModel = nil
+		case constant.GitRepoOperationOptionPopUp:
+			popUp, ok := m.PopUpModel.(*operationPopUp.GitRepoOperationOptionPopUpModel)
+			if ok {
+				selectedOption, ok := popUp.RepoOperationOptionList.SelectedItem().(operationPopUp.GitRepoOperationOptionItem)
+				if ok {
+					operation := popUp.Operation
+					operationPopUp.InitGitRepoOperationOutputPopUpModel(m, operation, selectedOption.Action)
+					outputPopUp, ok := m.PopUpModel.(*operationPopUp.GitRepoOperationOutputPopUpModel)
+					if ok {
+						outputPopUp.IsProcessing.Store(true)
+						m.PopUpType = constant.GitRepoOperationOutputPopUp
+						services.GitRepoOperationService(m, operation, selectedOption.Action)
+						m.ShowPopUp.Store(true)
+						m.IsTyping.Store(false)
+						return m, outputPopUp.Spinner.Tick
+					}
+				}
+			}
+		case constant.GitCleanPopUp:
+			popUp, ok := m.PopUpModel.(*cleanPopUp.GitCleanPopUpModel)
+			if ok {
+				entries := cleanPopUp.CheckedGitCleanEntries(popUp)
+				if len(entries) < 1 {
+					return m, nil
+				}
+				includeDirectories := popUp.IncludeDirectories
+				ignoredMode := popUp.IgnoredMode
+				batchPopUp.InitGitBatchOperationOutputPopUpModel(m, constant.BATCHCLEANFILES)
+				popUp, ok := m.PopUpModel.(*batchPopUp.GitBatchOperationOutputPopUpModel)
+				if ok {
+					popUp.IsProcessing.Store(true)
+					m.PopUpType = constant.GitBatchOperationOutputPopUp
+					services.GitCleanService(m, entries, includeDirectories, ignoredMode)
+					m.ShowPopUp.Store(true)
+					m.IsTyping.Store(false)
+					return m, popUp.Spinner.Tick
+				}
+			}
+		case constant.GitIgnoreRuleOptionPopUp:
+			popUp, ok := m.PopUpModel.(*ignorePopUp.GitIgnoreRuleOptionPopUpModel)
+			if ok {
+				selectedOption, ok := popUp.IgnoreRuleOptionList.SelectedItem().(ignorePopUp.GitIgnoreRuleOptionItem)
+				if ok {
+					ignorePopUp.InitGitIgnoreTargetOptionPopUpModel(m, popUp.FilePathName, selectedOption.RuleType)
+					m.PopUpType = constant.GitIgnoreTargetOptionPopUp
+					m.ShowPopUp.Store(true)
+					m.IsTyping.Store(false)
+				}
+			}
+		case constant.GitIgnoreTargetOptionPopUp:
+			popUp, ok := m.PopUpModel.(*ignorePopUp.GitIgnoreTargetOptionPopUpModel)
+			if ok {
+				selectedOption, ok := popUp.IgnoreTargetOptionList.SelectedItem().(ignorePopUp.GitIgnoreTargetOptionItem)
+				if ok {
+					services.GitWriteIgnoreRuleService(m, selectedOption.IgnoreRule)
+				}
+				m.ShowPopUp.Store(false)
+				m.IsTyping.Store(false)
+				m.PopUpType = constant.NoPopUp
+				m.PopUpModel = nil
+			}
+		case constant.GitRestoreSourcePopUp:
+			popUp, ok := m.PopUpModel.(*restorePopUp.GitRestoreSourcePopUpModel)
+			if ok {
+				selectedSource, ok := popUp.RestoreSourceList.SelectedItem().(restorePopUp.GitRestoreSourceItem)
+				if ok {
+					restorePopUp.InitGitRestoreTargetOptionPopUpModel(m, popUp.FilePathName, selectedSource.Revision, selectedSource.RevisionName)
+					m.PopUpType = constant.GitRestoreTargetOptionPopUp
+					m.ShowPopUp.Store(true)
+					m.IsTyping.Store(false)
+				}
+			}
+		case constant.GitRestoreFilePopUp:
+			popUp, ok := m.PopUpModel.(*restorePopUp.GitRestoreFilePopUpModel)
+			if ok {
+				selectedFile, ok := popUp.RestoreFileList.SelectedItem().(restorePopUp.GitRestoreFileItem)
+				if ok {
+					restorePopUp.InitGitRestoreTargetOptionPopUpModel(m, selectedFile.FilePathName, popUp.CommitHash, restorePopUp.ShortRevision(popUp.CommitHash))
+					m.PopUpType = constant.GitRestoreTargetOptionPopUp
+					m.ShowPopUp.Store(true)
+					m.IsTyping.Store(false)
+				}
+			}
+		case constant.GitRestoreTargetOptionPopUp:
+			popUp, ok := m.PopUpModel.(*restorePopUp.GitRestoreTargetOptionPopUpModel)
+			if ok {
+				selectedOption, ok := popUp.RestoreTargetOptionList.SelectedItem().(restorePopUp.GitRestoreTargetOptionItem)
+				if ok {
+					// only prompt for confirmation when something that can't be recovered from git will be lost
+					isExistsInRevision := m.GitOperations.GitFiles.FileExistsInRevision(popUp.Revision, popUp.FilePathName)
+					if !isExistsInRevision || m.GitOperations.GitFiles.HasLocalChangesFor(popUp.FilePathName, selectedOption.RestoreTarget) {
+						restorePopUp.InitGitRestoreConfirmPromptPopUpModel(m, popUp.FilePathName, popUp.Revision, popUp.RevisionName, selectedOption.RestoreTarget, isExistsInRevision)
+						m.PopUpType = constant.GitRestoreConfirmPromptPopUp
+						m.ShowPopUp.Store(true)
+						m.IsTyping.Store(false)
+						return m, nil
+					}
+					services.GitRestoreFileService(m, popUp.FilePathName, popUp.Revision, selectedOption.RestoreTarget)
+				}
+				m.ShowPopUp.Store(false)
+				m.IsTyping.Store(false)
+				m.PopUpType = constant.NoPopUp
+				m.PopUpModel = nil
+			}
+		case constant.GitRestoreConfirmPromptPopUp:
+			popUp, ok := m.PopUpModel.(*restorePopUp.GitRestoreConfirmPromptPopUpModel)
+			if ok {
+				services.GitRestoreFileService(m, popUp.FilePathName, popUp.Revision, popUp.RestoreTarget)
+				m.ShowPopUp.Store(false)
+				m.IsTyping.Store(false)
+				m.PopUpType = constant.NoPopUp
+				m.PopUpModel = nil
+			}
+		case constant.GitCommitLogScopeOptionPopUp:
+			popUp, ok := m.PopUpModel.(*logScopePopUp.GitCommitLogScopeOptionPopUpModel)
+			if ok {
+				selectedOption, ok := popUp.ScopeOptionList.SelectedItem().(logScopePopUp.GitCommitLogScopeOptionItem)
+				if ok {
+					// the branches are to be chosen first before the scope can be applied
+					if selectedOption.Mode == git.COMMITLOGSCOPESELECTEDBRANCHES {
+						logScopePopUp.InitGitCommitLogScopeBranchesPopUpModel(m)
+						m.PopUpType = constant.GitCommitLogScopeBranchesPopUp
+						m.ShowPopUp.Store(true)
+						m.IsTyping.Store(false)
+						return m, nil
+					}
+					services.GitCommitLogScopeService(m, git.CommitLogScope{Mode: selectedOption.Mode})
+				}
+				m.ShowPopUp.Store(false)
+				m.IsTyping.Store(false)
+				m.PopUpType = constant.NoPopUp
+				m.PopUpModel = nil
+			}
+		case constant.GitCommitParentOptionPopUp:
+			popUp, ok := m.PopUpModel.(*commitParentPopUp.GitCommitParentOptionPopUpModel)
+			if ok {
+				m.ShowPopUp.Store(false)
+				m.IsTyping.Store(false)
+				m.PopUpType = constant.NoPopUp
+				m.PopUpModel = nil
+				selectedOption, ok := popUp.ParentOptionList.SelectedItem().(commitParentPopUp.GitCommitParentOptionItem)
+				if ok {
+					services.GitCommitLogFilesViewService(m, popUp.CommitHash, selectedOption.Parent.Hash, selectedOption.ParentNumber)
+				}
+			}
+		case constant.GitCommitLogScopeBranchesPopUp:
+			popUp, ok := m.PopUpModel.(*logScopePopUp.GitCommitLogScopeBranchesPopUpModel)
+			if ok {
+				branches := logScopePopUp.CheckedGitCommitLogScopeBranches(popUp)
+				if len(branches) < 1 {
+					return m, nil
+				}
+				services.GitCommitLogScopeService(m, git.CommitLogScope{Mode: git.COMMITLOGSCOPESELECTEDBRANCHES, Branches: branches})
+				m.ShowPopUp.Store(false)
+				m.IsTyping.Store(false)
+				m.PopUpType = constant.NoPopUp
+				m.PopUpModel = nil
+			}
+		case constant.GitPathspecActionOptionPopUp:
+			popUp, ok := m.PopUpModel.(*pathspecPopUp.GitPathspecActionOptionPopUpModel)
+			if ok {
+				selectedOption, ok := popUp.PathspecActionOptionList.SelectedItem().(pathspecPopUp.GitPathspecActionOptionItem)
+				if ok {
+					items := make([]string, 0, len(popUp.MatchedFiles))
+					itemsDisplayName := make([]string, 0, len(popUp.MatchedFiles))
+					for _, file := range popUp.MatchedFiles {
+						items = append(items, file.FilePathname)
+						itemsDisplayName = append(itemsDisplayName, file.DisplayPathname())
+					}
+					switch selectedOption.Action {
+					case constant.PATHSPECSTAGEFILES:
+						services.GitStageFilesService(m, items)
+					case constant.PATHSPECUNSTAGEFILES:
+						services.GitUnstageFilesService(m, items)
+					case constant.BATCHDISCARDFILES, constant.BATCHSTASHFILES:
+						// discard and stash can't be undone easily, so they go through the same confirm prompt as the marked files
+						batchPopUp.InitGitBatchOperationConfirmPromptPopUpModel(m, selectedOption.Action, items, itemsDisplayName)
+						m.PopUpType = constant.GitBatchOperationConfirmPromptPopUp
+						m.ShowPopUp.Store(true)
+						m.IsTyping.Store(false)
+						return m, nil
+					}
+				}
 				m.ShowPopUp.Store(false)
 				m.IsTyping.Store(false)
+				m.PopUpType = constant.NoPopUp
+				m.PopUpModel = nil
+			}
+		case constant.GitBatchOperationConfirmPromptPopUp:
+			popUp, ok := m.PopUpModel.(*batchPopUp.GitBatchOperationConfirmPromptPopUpModel)
+			if ok {
+				batchOperationType := popUp.BatchOperationType
+				items := popUp.Items
+				// the marks are done with once the operation started
+				switch batchOperationType {
+				case constant.BATCHDELETEBRANCHES:
+					clear(m.ListMarkedItems.LocalBranchComponent)
+				case constant.BATCHDISCARDFILES, constant.BATCHSTASHFILES:
+					clear(m.ListMarkedItems.ModifiedFilesComponent)
+				case constant.BATCHDROPSTASHES:
+					clear(m.ListMarkedItems.StashComponent)
+				}
+				batchPopUp.InitGitBatchOperationOutputPopUpModel(m, batchOperationType)
+				popUp, ok := m.PopUpModel.(*batchPopUp.GitBatchOperationOutputPopUpModel)
+				if ok {
+					popUp.IsProcessing.Store(true)
+					m.PopUpType = constant.GitBatchOperationOutputPopUp
+					services.GitBatchOperationService(m, batchOperationType, items)
+					m.ShowPopUp.Store(true)
+					m.IsTyping.Store(false)
+					return m, popUp.Spinner.Tick
+				}
 			}
 		case constant.GitDeleteBranchConfirmPromptPopUp:
 			popUp, ok := m.PopUpModel.(*branchPopUp.GitDeleteBranchConfirmPromptPopUpModel)
@@ -573,11 +1144,23 @@ func handleNonTypingSpaceKeyBindingInteraction(m *types.GittiModel) (*types.Gitt
 	if !m.ShowPopUp.Load() {
 		switch m.CurrentSelectedComponent {
 		case constant.ModifiedFilesComponent:
+			if len(m.ListMarkedItems.ModifiedFilesComponent) > 0 {
+				markedFiles, _ := markedListItems(m, constant.ModifiedFilesComponent)
+				services.GitStageOrUnstageFilesService(m, markedFiles)
+				return m, nil
+			}
 			currentSelectedModifiedFile := m.CurrentRepoModifiedFilesInfoList.SelectedItem()
-			var filePathName string
-			if currentSelectedModifiedFile != nil {
-				filePathName = currentSelectedModifiedFile.(files.GitModifiedFilesItem).FilePathname
-				services.GitStageOrUnstageService(m, filePathName)
+			switch selectedItem := currentSelectedModifiedFile.(type) {
+			case files.GitModifiedFilesItem:
+				services.GitStageOrUnstageService(m, selectedItem.FilePathname)
+			case files.GitModifiedFilesDirectoryItem:
+				services.GitStageOrUnstageDirectoryService(m, selectedItem.DirectoryPath)
+			}
+
+		case constant.DetailComponent, constant.DetailComponentTwo:
+			// stage or unstage the hunk/line under the diff cursor
+			if m.DetailPanelParentComponent == constant.ModifiedFilesComponent {
+				return m, services.GitStageOrUnstageDiffCursorSelectionService(m)
 			}
 
 		case constant.StashComponent:
@@ -589,6 +1172,10 @@ func handleNonTypingSpaceKeyBindingInteraction(m *types.GittiModel) (*types.Gitt
 				m.IsTyping.Store(false)
 			}
 		}
+	} else if m.PopUpType == constant.GitCleanPopUp {
+		cleanPopUp.ToggleGitCleanEntry(m)
+	} else if m.PopUpType == constant.GitCommitLogScopeBranchesPopUp {
+		logScopePopUp.ToggleGitCommitLogScopeBranch(m)
 	}
 	return m, nil
 }
@@ -672,12 +1259,86 @@ func handleNonTypingEscKeyBindingInteraction(m *types.GittiModel) (*types.GittiM
 			m.PopUpType = constant.NoPopUp
 			m.PopUpModel = nil
 
+		case constant.GitConflictEditorPopUp:
+			m.ShowPopUp.Store(false)
+			m.IsTyping.Store(false)
+			m.PopUpType = constant.NoPopUp
+			m.PopUpModel = nil
+
+		case constant.GitMergeToolResultPopUp, constant.GitApplyPatchErrorPopUp:
+			m.ShowPopUp.Store(false)
+			m.IsTyping.Store(false)
+			m.PopUpType = constant.NoPopUp
+			m.PopUpModel = nil
+
+		case constant.GitRepoOperationOptionPopUp:
+			m.ShowPopUp.Store(false)
+			m.IsTyping.Store(false)
+			m.PopUpType = constant.NoPopUp
+			m.PopUpModel = nil
+
+		case constant.GitPathspecActionOptionPopUp:
+			m.ShowPopUp.Store(false)
+			m.IsTyping.Store(false)
+			m.PopUpType = constant.NoPopUp
+			m.PopUpModel = nil
+
+		case constant.GitIgnoreRuleOptionPopUp, constant.GitIgnoreTargetOptionPopUp:
+			m.ShowPopUp.Store(false)
+			m.IsTyping.Store(false)
+			m.PopUpType = constant.NoPopUp
+			m.PopUpModel = nil
+
+		case constant.GitRestoreSourcePopUp, constant.GitRestoreFilePopUp, constant.GitRestoreTargetOptionPopUp, constant.GitRestoreConfirmPromptPopUp:
+			m.ShowPopUp.Store(false)
+			m.IsTyping.Store(false)
+			m.PopUpType = constant.NoPopUp
+			m.PopUpModel = nil
+
+		case constant.GitCleanPopUp:
+			m.ShowPopUp.Store(false)
+			m.IsTyping.Store(false)
+			m.PopUpType = constant.NoPopUp
+			m.PopUpModel = nil
+
+		case constant.GitCommitLogScopeOptionPopUp, constant.GitCommitLogScopeBranchesPopUp, constant.GitCommitParentOptionPopUp:
+			m.ShowPopUp.Store(false)
+			m.IsTyping.Store(false)
+			m.PopUpType = constant.NoPopUp
+			m.PopUpModel = nil
+
+		case constant.GitRepoOperationOutputPopUp:
+			popUp, ok := m.PopUpModel.(*operationPopUp.GitRepoOperationOutputPopUpModel)
+			if ok && !popUp.IsProcessing.Load() {
+				// only close when done processing, stopping a continue or abort halfway might leave the repo in a broken state
+				m.ShowPopUp.Store(false)
+				m.IsTyping.Store(false)
+				m.PopUpType = constant.NoPopUp
+				m.PopUpModel = nil
+			}
+
 		case constant.GitDeleteBranchConfirmPromptPopUp:
 			m.ShowPopUp.Store(false)
 			m.IsTyping.Store(false)
 			m.PopUpType = constant.NoPopUp
 			m.PopUpModel = nil
 
+		case constant.GitBatchOperationConfirmPromptPopUp:
+			m.ShowPopUp.Store(false)
+			m.IsTyping.Store(false)
+			m.PopUpType = constant.NoPopUp
+			m.PopUpModel = nil
+
+		case constant.GitBatchOperationOutputPopUp:
+			popUp, ok := m.PopUpModel.(*batchPopUp.GitBatchOperationOutputPopUpModel)
+			if ok && !popUp.IsProcessing.Load() {
+				// only close when done processing
+				m.ShowPopUp.Store(false)
+				m.IsTyping.Store(false)
+				m.PopUpType = constant.NoPopUp
+				m.PopUpModel = nil
+			}
+
 		case constant.GitDeleteBranchOutputPopUp:
 			popUp, ok := m.PopUpModel.(*branchPopUp.GitDeleteBranchOutputPopUpModel)
 			if ok && !popUp.IsProcessing.Load() {
@@ -705,9 +1366,21 @@ func handleNonTypingEscKeyBindingInteraction(m *types.GittiModel) (*types.GittiM
 		case constant.DetailComponent:
 			m.CurrentSelectedComponent = m.DetailPanelParentComponent
 			m.DetailPanelParentComponent = ""
+			services.RefreshDetailPanelDiffCursorContent(m)
 		case constant.DetailComponentTwo:
 			m.CurrentSelectedComponent = m.DetailPanelParentComponent
 			m.DetailPanelParentComponent = ""
+			services.RefreshDetailPanelDiffCursorContent(m)
+		case constant.CommitLogComponent:
+			// go back to the commit log from the changed files of the commit, before the filter is cleared
+			if m.IsCommitLogFilesView {
+				services.GitCommitLogFilesViewExitService(m)
+				return m, nil
+			}
+			// clear the filter of the commit log
+			if !m.GitOperations.GitCommitLog.CommitLogFilter().IsEmpty() {
+				services.GitCommitLogFilterService(m, git.CommitLogFilter{})
+			}
 		}
 	}
 	return m, nil
@@ -734,6 +1407,13 @@ func handleNonTypingUpkKeyBindingInteraction(msg tea.KeyMsg, m *types.GittiModel
 				services.FetchDetailComponentPanelInfoService(m, true)
 			}
 		case constant.CommitLogComponent:
+			if m.IsCommitLogFilesView {
+				if m.CurrentRepoCommitLogFilesInfoList.Index() > 0 {
+					m.CurrentRepoCommitLogFilesInfoList.Select(m.CurrentRepoCommitLogFilesInfoList.Index() - 1)
+					services.FetchDetailComponentPanelInfoService(m, true)
+				}
+				return m, nil
+			}
 			// we don't use the list native Update() because we need to also track the current selected index
 			if m.CurrentRepoCommitLogInfoList.Index() > 0 {
 				latestIndex := m.CurrentRepoCommitLogInfoList.Index() - 1
@@ -750,9 +1430,16 @@ func handleNonTypingUpkKeyBindingInteraction(msg tea.KeyMsg, m *types.GittiModel
 				services.FetchDetailComponentPanelInfoService(m, true)
 			}
 		case constant.DetailComponent:
+			// for file diff, move the hunk/line cursor instead of scrolling
+			if services.MoveDetailPanelDiffCursor(m, -1) || services.MoveDetailPanelBlameCursor(m, -1) {
+				return m, nil
+			}
 			m.DetailPanelViewport, cmd = m.DetailPanelViewport.Update(msg)
 			return m, cmd
 		case constant.DetailComponentTwo:
+			if services.MoveDetailPanelDiffCursor(m, -1) {
+				return m, nil
+			}
 			m.DetailPanelTwoViewport, cmd = m.DetailPanelTwoViewport.Update(msg)
 			return m, cmd
 		}
@@ -783,6 +1470,13 @@ func handleNonTypingDownjKeyBindingInteraction(msg tea.KeyMsg, m *types.GittiMod
 				services.FetchDetailComponentPanelInfoService(m, true)
 			}
 		case constant.CommitLogComponent:
+			if m.IsCommitLogFilesView {
+				if m.CurrentRepoCommitLogFilesInfoList.Index() < len(m.CurrentRepoCommitLogFilesInfoList.Items())-1 {
+					m.CurrentRepoCommitLogFilesInfoList.Select(m.CurrentRepoCommitLogFilesInfoList.Index() + 1)
+					services.FetchDetailComponentPanelInfoService(m, true)
+				}
+				return m, nil
+			}
 			// we don't use the list native Update() because we need to also track the current selected index
 			if m.CurrentRepoCommitLogInfoList.Index() < len(m.CurrentRepoCommitLogInfoList.Items())-1 {
 				latestIndex := m.CurrentRepoCommitLogInfoList.Index() + 1
@@ -790,6 +1484,7 @@ func handleNonTypingDownjKeyBindingInteraction(msg tea.KeyMsg, m *types.GittiMod
 				m.ListNavigationIndexPosition.CommitLogComponent = latestIndex
 				services.FetchDetailComponentPanelInfoService(m, true)
 			}
+			services.GitLoadNextCommitLogPageService(m)
 		case constant.StashComponent:
 			// we don't use the list native Update() because we need to also track the current selected index
 			if m.CurrentRepoStashInfoList.Index() < len(m.CurrentRepoStashInfoList.Items())-1 {
@@ -799,9 +1494,16 @@ func handleNonTypingDownjKeyBindingInteraction(msg tea.KeyMsg, m *types.GittiMod
 				services.FetchDetailComponentPanelInfoService(m, true)
 			}
 		case constant.DetailComponent:
+			// for file diff, move the hunk/line cursor instead of scrolling
+			if services.MoveDetailPanelDiffCursor(m, 1) || services.MoveDetailPanelBlameCursor(m, 1) {
+				return m, nil
+			}
 			m.DetailPanelViewport, cmd = m.DetailPanelViewport.Update(msg)
 			return m, cmd
 		case constant.DetailComponentTwo:
+			if services.MoveDetailPanelDiffCursor(m, 1) {
+				return m, nil
+			}
 			m.DetailPanelTwoViewport, cmd = m.DetailPanelTwoViewport.Update(msg)
 			return m, cmd
 		}
@@ -859,12 +1561,91 @@ func handleNonTypingRightlKeyBindingInteraction(msg tea.KeyMsg, m *types.GittiMo
 	return m, nil
 }
 
+// handleNonTypingVerticalBarKeyBindingInteraction handles the '|' key to switch the diff in detail component panel between unified and side by side view
+func handleNonTypingVerticalBarKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if !m.ShowPopUp.Load() && isDiffComponentSelected(m) {
+		m.IsSideBySideDiffView = !m.IsSideBySideDiffView
+		if m.IsSideBySideDiffView {
+			settings.UpdateDiffViewMode(settings.DIFFVIEWMODESIDEBYSIDE)
+		} else {
+			settings.UpdateDiffViewMode(settings.DIFFVIEWMODEUNIFIED)
+		}
+		// the content of detail panel two will be a different thing after the switch
+		if m.CurrentSelectedComponent == constant.DetailComponentTwo {
+			m.CurrentSelectedComponent = constant.DetailComponent
+		}
+		services.FetchDetailComponentPanelInfoService(m, true)
+	}
+	return m, nil
+}
+
+// handleNonTypingwKeyBindingInteraction handles the 'w' key to cycle how whitespace changes are treated in diff
+func handleNonTypingwKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if !m.ShowPopUp.Load() && isDiffComponentSelected(m) {
+		switch m.DiffOptions.Whitespace {
+		case git.DIFFWHITESPACESHOWALL:
+			m.DiffOptions.Whitespace = git.DIFFIGNORESPACECHANGE
+		case git.DIFFIGNORESPACECHANGE:
+			m.DiffOptions.Whitespace = git.DIFFIGNOREALLSPACE
+		default:
+			m.DiffOptions.Whitespace = git.DIFFWHITESPACESHOWALL
+		}
+		services.FetchDetailComponentPanelInfoService(m, true)
+	}
+	return m, nil
+}
+
+// handleNonTypingxKeyBindingInteraction handles the 'x' key to cycle how the clean pop up treats ignored files
+func handleNonTypingxKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if m.ShowPopUp.Load() && m.PopUpType == constant.GitCleanPopUp {
+		cleanPopUp.CycleGitCleanIgnoredMode(m)
+	}
+	return m, nil
+}
+
+// handleNonTypingLeftBraceKeyBindingInteraction handles the '{' key to show less context lines in diff
+func handleNonTypingLeftBraceKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if !m.ShowPopUp.Load() && isDiffComponentSelected(m) && m.DiffOptions.ContextLines > 0 {
+		m.DiffOptions.ContextLines--
+		services.FetchDetailComponentPanelInfoService(m, true)
+	}
+	return m, nil
+}
+
+// handleNonTypingRightBraceKeyBindingInteraction handles the '}' key to show more context lines in diff
+func handleNonTypingRightBraceKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if !m.ShowPopUp.Load() && isDiffComponentSelected(m) && m.DiffOptions.ContextLines < git.MAXDIFFCONTEXTLINES {
+		m.DiffOptions.ContextLines++
+		services.FetchDetailComponentPanelInfoService(m, true)
+	}
+	return m, nil
+}
+
+// handleNonTypingLessThanKeyBindingInteraction handles the '<' key to show the previous page of a large file diff
+func handleNonTypingLessThanKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if !m.ShowPopUp.Load() && isDetailPanelDiffPaged(m) && m.DetailPanelDiffPage.Page > 0 {
+		m.DetailPanelDiffPage.Page--
+		services.FetchDetailComponentPanelInfoService(m, true)
+	}
+	return m, nil
+}
+
+// handleNonTypingGreaterThanKeyBindingInteraction handles the '>' key to show the next page of a large file diff
+func handleNonTypingGreaterThanKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if !m.ShowPopUp.Load() && isDetailPanelDiffPaged(m) && m.DetailPanelDiffPage.HasNextPage {
+		m.DetailPanelDiffPage.Page++
+		services.FetchDetailComponentPanelInfoService(m, true)
+	}
+	return m, nil
+}
+
 // handleNonTypingLeftBracketKeyBindingInteraction handles the '[' key not only for navigation but contextually to switch to the previous detail component panel
 func handleNonTypingLeftBracketKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
 	if !m.ShowPopUp.Load() {
 		// handle detail component panel switching
 		if m.CurrentSelectedComponent == constant.DetailComponentTwo {
 			m.CurrentSelectedComponent = constant.DetailComponent
+			services.RefreshDetailPanelDiffCursorContent(m)
 		}
 	}
 	return m, nil
@@ -876,7 +1657,45 @@ func handleNonTypingRightBracketKeyBindingInteraction(m *types.GittiModel) (*typ
 		// handle detail component panel switching
 		if m.CurrentSelectedComponent == constant.DetailComponent && m.ShowDetailPanelTwo.Load() {
 			m.CurrentSelectedComponent = constant.DetailComponentTwo
+			services.RefreshDetailPanelDiffCursorContent(m)
 		}
 	}
 	return m, nil
 }
+
+// handleNonTypingSlashKeyBindingInteraction handles the '/' key to filter the commit log
+func handleNonTypingSlashKeyBindingInteraction(m *types.GittiModel) (*types.GittiModel, tea.Cmd) {
+	if !m.ShowPopUp.Load() && m.CurrentSelectedComponent == constant.CommitLogComponent && !m.IsCommitLogFilesView {
+		m.PopUpType = constant.GitCommitLogFilterPopUp
+		logFilterPopUp.InitGitCommitLogFilterPopUpModel(m)
+		m.ShowPopUp.Store(true)
+		m.IsTyping.Store(true)
+	}
+	return m, nil
+}
+
+// open the confirm prompt for running an operation on all the marked items of the current list
+func initGitBatchOperationConfirmPromptPopUp(m *types.GittiModel, batchOperationType string) (*types.GittiModel, tea.Cmd) {
+	var items []string
+	var itemsDisplayName []string
+	switch batchOperationType {
+	case constant.BATCHDELETEBRANCHES:
+		items, itemsDisplayName = markedListItems(m, constant.LocalBranchComponent)
+	case constant.BATCHDISCARDFILES, constant.BATCHSTASHFILES:
+		items, itemsDisplayName = markedListItems(m, constant.ModifiedFilesComponent)
+	case constant.BATCHDROPSTASHES:
+		items, itemsDisplayName = markedListItems(m, constant.StashComponent)
+		// drop from the oldest stash, so the id of the remaining marked stash will not shift
+		slices.Reverse(items)
+		slices.Reverse(itemsDisplayName)
+	}
+	if len(items) < 1 {
+		return m, nil
+	}
+
+	batchPopUp.InitGitBatchOperationConfirmPromptPopUpModel(m, batchOperationType, items, itemsDisplayName)
+	m.PopUpType = constant.GitBatchOperationConfirmPromptPopUp
+	m.ShowPopUp.Store(true)
+	m.IsTyping.Store(false)
+	return m, nil
+}
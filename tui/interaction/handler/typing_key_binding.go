@@ -7,6 +7,8 @@ import (
 	"github.com/gohyuhan/gitti/tui/constant"
 	branchPopUp "github.com/gohyuhan/gitti/tui/popup/branch"
 	commitPopUp "github.com/gohyuhan/gitti/tui/popup/commit"
+	logFilterPopUp "github.com/gohyuhan/gitti/tui/popup/logfilter"
+	pathspecPopUp "github.com/gohyuhan/gitti/tui/popup/pathspec"
 	remotePopUp "github.com/gohyuhan/gitti/tui/popup/remote"
 	stashPopUp "github.com/gohyuhan/gitti/tui/popup/stash"
 	"github.com/gohyuhan/gitti/tui/services"
@@ -36,6 +38,16 @@ func handleTypingESCKeyBindingInteraction(m *types.GittiModel) (*types.GittiMode
 		m.IsTyping.Store(false)
 		m.PopUpType = constant.NoPopUp
 		m.PopUpModel = nil
+	case constant.GitPathspecPopUp:
+		m.ShowPopUp.Store(false)
+		m.IsTyping.Store(false)
+		m.PopUpType = constant.NoPopUp
+		m.PopUpModel = nil
+	case constant.GitCommitLogFilterPopUp:
+		m.ShowPopUp.Store(false)
+		m.IsTyping.Store(false)
+		m.PopUpType = constant.NoPopUp
+		m.PopUpModel = nil
 	}
 	return m, nil
 }
@@ -81,6 +93,11 @@ func handleTypingTabKeyBindingInteraction(m *types.GittiModel) (*types.GittiMode
 				popUp.RemoteUrlTextInput.Focus()
 			}
 		}
+	case constant.GitCommitLogFilterPopUp:
+		popUp, ok := m.PopUpModel.(*logFilterPopUp.GitCommitLogFilterPopUpModel)
+		if ok {
+			popUp.FocusInput(min(popUp.CurrentActiveInputIndex+1, popUp.TotalInputCount))
+		}
 	}
 	return m, nil
 }
@@ -126,6 +143,11 @@ func handleTypingShiftTabKeyBindingInteraction(m *types.GittiModel) (*types.Gitt
 				popUp.RemoteUrlTextInput.Focus()
 			}
 		}
+	case constant.GitCommitLogFilterPopUp:
+		popUp, ok := m.PopUpModel.(*logFilterPopUp.GitCommitLogFilterPopUpModel)
+		if ok {
+			popUp.FocusInput(max(popUp.CurrentActiveInputIndex-1, 1))
+		}
 	}
 	return m, nil
 }
@@ -216,6 +238,32 @@ func handleTypingEnterKeyBindingInteraction(m *types.GittiModel) (*types.GittiMo
 			m.PopUpType = constant.GitStashConfirmPromptPopUp
 		}
 
+	case constant.GitPathspecPopUp:
+		popUp, ok := m.PopUpModel.(*pathspecPopUp.GitPathspecPopUpModel)
+		if ok {
+			// match again as the files status might have changed since the last key stroke
+			pathspecPopUp.UpdateGitPathspecPreview(m)
+			if len(popUp.MatchedFiles) > 0 {
+				pathspecPopUp.InitGitPathspecActionOptionPopUpModel(m, popUp.PathspecInput.Value(), popUp.MatchedFiles)
+				m.ShowPopUp.Store(true)
+				m.IsTyping.Store(false)
+				m.PopUpType = constant.GitPathspecActionOptionPopUp
+			}
+		}
+
+	case constant.GitCommitLogFilterPopUp:
+		popUp, ok := m.PopUpModel.(*logFilterPopUp.GitCommitLogFilterPopUpModel)
+		if ok {
+			// an empty filter will clear the filter
+			popUp.HasPickaxeConflict = !services.GitCommitLogFilterService(m, popUp.Filter())
+			if !popUp.HasPickaxeConflict {
+				m.ShowPopUp.Store(false)
+				m.IsTyping.Store(false)
+				m.PopUpType = constant.NoPopUp
+				m.PopUpModel = nil
+			}
+		}
+
 	case constant.CreateBranchBasedOnRemotePopUp:
 		popUp, ok := m.PopUpModel.(*branchPopUp.CreateBranchBasedOnRemotePopUpModel)
 		if ok {
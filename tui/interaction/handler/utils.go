@@ -2,15 +2,24 @@ package handler
 
 import (
 	tea "charm.land/bubbletea/v2"
+	"github.com/gohyuhan/gitti/tui/component/branch"
+	"github.com/gohyuhan/gitti/tui/component/stash"
 	"github.com/gohyuhan/gitti/tui/constant"
 	branchPopUp "github.com/gohyuhan/gitti/tui/popup/branch"
+	cleanPopUp "github.com/gohyuhan/gitti/tui/popup/clean"
 	commitPopUp "github.com/gohyuhan/gitti/tui/popup/commit"
+	commitParentPopUp "github.com/gohyuhan/gitti/tui/popup/commitparent"
 	discardPopUp "github.com/gohyuhan/gitti/tui/popup/discard"
+	ignorePopUp "github.com/gohyuhan/gitti/tui/popup/ignore"
 	keybindingPopUp "github.com/gohyuhan/gitti/tui/popup/keybinding"
+	logScopePopUp "github.com/gohyuhan/gitti/tui/popup/logscope"
+	operationPopUp "github.com/gohyuhan/gitti/tui/popup/operation"
+	pathspecPopUp "github.com/gohyuhan/gitti/tui/popup/pathspec"
 	pullPopUp "github.com/gohyuhan/gitti/tui/popup/pull"
 	pushPopUp "github.com/gohyuhan/gitti/tui/popup/push"
 	remotePopUp "github.com/gohyuhan/gitti/tui/popup/remote"
 	resolvePopUp "github.com/gohyuhan/gitti/tui/popup/resolve"
+	restorePopUp "github.com/gohyuhan/gitti/tui/popup/restore"
 	stashPopUp "github.com/gohyuhan/gitti/tui/popup/stash"
 	"github.com/gohyuhan/gitti/tui/types"
 	"github.com/gohyuhan/gitti/tui/utils"
@@ -147,6 +156,213 @@ func UpDownKeyMsgUpdateForPopUp(msg tea.KeyMsg, m *types.GittiModel) (*types.Git
 			popUp.ResolveConflictOptionList.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &popUp.ResolveConflictOptionList, constant.MaxGitResolveConflictOptionPopUpWidth)
 			return m, nil
 		}
+	case constant.GitRepoOperationOptionPopUp:
+		popUp, ok := m.PopUpModel.(*operationPopUp.GitRepoOperationOptionPopUpModel)
+		if ok {
+			switch msg.String() {
+			case "up", "k":
+				if popUp.RepoOperationOptionList.Index() > 0 {
+					latestIndex := popUp.RepoOperationOptionList.Index() - 1
+					popUp.RepoOperationOptionList.Select(latestIndex)
+				}
+			case "down", "j":
+				if popUp.RepoOperationOptionList.Index() < len(popUp.RepoOperationOptionList.Items())-1 {
+					latestIndex := popUp.RepoOperationOptionList.Index() + 1
+					popUp.RepoOperationOptionList.Select(latestIndex)
+				}
+			}
+			popUp.RepoOperationOptionList.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &popUp.RepoOperationOptionList, constant.MaxGitRepoOperationOptionPopUpWidth)
+			return m, nil
+		}
+	case constant.GitPathspecActionOptionPopUp:
+		popUp, ok := m.PopUpModel.(*pathspecPopUp.GitPathspecActionOptionPopUpModel)
+		if ok {
+			switch msg.String() {
+			case "up", "k":
+				if popUp.PathspecActionOptionList.Index() > 0 {
+					latestIndex := popUp.PathspecActionOptionList.Index() - 1
+					popUp.PathspecActionOptionList.Select(latestIndex)
+				}
+			case "down", "j":
+				if popUp.PathspecActionOptionList.Index() < len(popUp.PathspecActionOptionList.Items())-1 {
+					latestIndex := popUp.PathspecActionOptionList.Index() + 1
+					popUp.PathspecActionOptionList.Select(latestIndex)
+				}
+			}
+			popUp.PathspecActionOptionList.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &popUp.PathspecActionOptionList, constant.MaxGitPathspecActionOptionPopUpWidth)
+			return m, nil
+		}
+	case constant.GitCleanPopUp:
+		popUp, ok := m.PopUpModel.(*cleanPopUp.GitCleanPopUpModel)
+		if ok {
+			switch msg.String() {
+			case "up", "k":
+				if popUp.CleanEntryList.Index() > 0 {
+					latestIndex := popUp.CleanEntryList.Index() - 1
+					popUp.CleanEntryList.Select(latestIndex)
+				}
+			case "down", "j":
+				if popUp.CleanEntryList.Index() < len(popUp.CleanEntryList.Items())-1 {
+					latestIndex := popUp.CleanEntryList.Index() + 1
+					popUp.CleanEntryList.Select(latestIndex)
+				}
+			}
+			popUp.CleanEntryList.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &popUp.CleanEntryList, constant.MaxGitCleanPopUpWidth)
+			return m, nil
+		}
+	case constant.GitIgnoreRuleOptionPopUp:
+		popUp, ok := m.PopUpModel.(*ignorePopUp.GitIgnoreRuleOptionPopUpModel)
+		if ok {
+			switch msg.String() {
+			case "up", "k":
+				if popUp.IgnoreRuleOptionList.Index() > 0 {
+					latestIndex := popUp.IgnoreRuleOptionList.Index() - 1
+					popUp.IgnoreRuleOptionList.Select(latestIndex)
+				}
+			case "down", "j":
+				if popUp.IgnoreRuleOptionList.Index() < len(popUp.IgnoreRuleOptionList.Items())-1 {
+					latestIndex := popUp.IgnoreRuleOptionList.Index() + 1
+					popUp.IgnoreRuleOptionList.Select(latestIndex)
+				}
+			}
+			popUp.IgnoreRuleOptionList.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &popUp.IgnoreRuleOptionList, constant.MaxGitIgnoreRuleOptionPopUpWidth)
+			return m, nil
+		}
+	case constant.GitIgnoreTargetOptionPopUp:
+		popUp, ok := m.PopUpModel.(*ignorePopUp.GitIgnoreTargetOptionPopUpModel)
+		if ok {
+			switch msg.String() {
+			case "up", "k":
+				if popUp.IgnoreTargetOptionList.Index() > 0 {
+					latestIndex := popUp.IgnoreTargetOptionList.Index() - 1
+					popUp.IgnoreTargetOptionList.Select(latestIndex)
+				}
+			case "down", "j":
+				if popUp.IgnoreTargetOptionList.Index() < len(popUp.IgnoreTargetOptionList.Items())-1 {
+					latestIndex := popUp.IgnoreTargetOptionList.Index() + 1
+					popUp.IgnoreTargetOptionList.Select(latestIndex)
+				}
+			}
+			popUp.IgnoreTargetOptionList.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &popUp.IgnoreTargetOptionList, constant.MaxGitIgnoreTargetOptionPopUpWidth)
+			return m, nil
+		}
+	case constant.GitRestoreSourcePopUp:
+		popUp, ok := m.PopUpModel.(*restorePopUp.GitRestoreSourcePopUpModel)
+		if ok {
+			switch msg.String() {
+			case "up", "k":
+				if popUp.RestoreSourceList.Index() > 0 {
+					latestIndex := popUp.RestoreSourceList.Index() - 1
+					popUp.RestoreSourceList.Select(latestIndex)
+				}
+			case "down", "j":
+				if popUp.RestoreSourceList.Index() < len(popUp.RestoreSourceList.Items())-1 {
+					latestIndex := popUp.RestoreSourceList.Index() + 1
+					popUp.RestoreSourceList.Select(latestIndex)
+				}
+			}
+			popUp.RestoreSourceList.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &popUp.RestoreSourceList, constant.MaxGitRestoreSourcePopUpWidth)
+			return m, nil
+		}
+	case constant.GitRestoreFilePopUp:
+		popUp, ok := m.PopUpModel.(*restorePopUp.GitRestoreFilePopUpModel)
+		if ok {
+			switch msg.String() {
+			case "up", "k":
+				if popUp.RestoreFileList.Index() > 0 {
+					latestIndex := popUp.RestoreFileList.Index() - 1
+					popUp.RestoreFileList.Select(latestIndex)
+				}
+			case "down", "j":
+				if popUp.RestoreFileList.Index() < len(popUp.RestoreFileList.Items())-1 {
+					latestIndex := popUp.RestoreFileList.Index() + 1
+					popUp.RestoreFileList.Select(latestIndex)
+				}
+			}
+			popUp.RestoreFileList.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &popUp.RestoreFileList, constant.MaxGitRestoreFilePopUpWidth)
+			return m, nil
+		}
+	case constant.GitRestoreTargetOptionPopUp:
+		popUp, ok := m.PopUpModel.(*restorePopUp.GitRestoreTargetOptionPopUpModel)
+		if ok {
+			switch msg.String() {
+			case "up", "k":
+				if popUp.RestoreTargetOptionList.Index() > 0 {
+					latestIndex := popUp.RestoreTargetOptionList.Index() - 1
+					popUp.RestoreTargetOptionList.Select(latestIndex)
+				}
+			case "down", "j":
+				if popUp.RestoreTargetOptionList.Index() < len(popUp.RestoreTargetOptionList.Items())-1 {
+					latestIndex := popUp.RestoreTargetOptionList.Index() + 1
+					popUp.RestoreTargetOptionList.Select(latestIndex)
+				}
+			}
+			popUp.RestoreTargetOptionList.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &popUp.RestoreTargetOptionList, constant.MaxGitRestoreTargetOptionPopUpWidth)
+			return m, nil
+		}
+	case constant.GitCommitLogScopeOptionPopUp:
+		popUp, ok := m.PopUpModel.(*logScopePopUp.GitCommitLogScopeOptionPopUpModel)
+		if ok {
+			switch msg.String() {
+			case "up", "k":
+				if popUp.ScopeOptionList.Index() > 0 {
+					latestIndex := popUp.ScopeOptionList.Index() - 1
+					popUp.ScopeOptionList.Select(latestIndex)
+				}
+			case "down", "j":
+				if popUp.ScopeOptionList.Index() < len(popUp.ScopeOptionList.Items())-1 {
+					latestIndex := popUp.ScopeOptionList.Index() + 1
+					popUp.ScopeOptionList.Select(latestIndex)
+				}
+			}
+			popUp.ScopeOptionList.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &popUp.ScopeOptionList, constant.MaxGitCommitLogScopeOptionPopUpWidth)
+			return m, nil
+		}
+	case constant.GitCommitLogScopeBranchesPopUp:
+		popUp, ok := m.PopUpModel.(*logScopePopUp.GitCommitLogScopeBranchesPopUpModel)
+		if ok {
+			switch msg.String() {
+			case "up", "k":
+				if popUp.BranchList.Index() > 0 {
+					latestIndex := popUp.BranchList.Index() - 1
+					popUp.BranchList.Select(latestIndex)
+				}
+			case "down", "j":
+				if popUp.BranchList.Index() < len(popUp.BranchList.Items())-1 {
+					latestIndex := popUp.BranchList.Index() + 1
+					popUp.BranchList.Select(latestIndex)
+				}
+			}
+			popUp.BranchList.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &popUp.BranchList, constant.MaxGitCommitLogScopeBranchesPopUpWidth)
+			return m, nil
+		}
+	case constant.GitCommitParentOptionPopUp:
+		popUp, ok := m.PopUpModel.(*commitParentPopUp.GitCommitParentOptionPopUpModel)
+		if ok {
+			switch msg.String() {
+			case "up", "k":
+				if popUp.ParentOptionList.Index() > 0 {
+					latestIndex := popUp.ParentOptionList.Index() - 1
+					popUp.ParentOptionList.Select(latestIndex)
+				}
+			case "down", "j":
+				if popUp.ParentOptionList.Index() < len(popUp.ParentOptionList.Items())-1 {
+					latestIndex := popUp.ParentOptionList.Index() + 1
+					popUp.ParentOptionList.Select(latestIndex)
+				}
+			}
+			popUp.ParentOptionList.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &popUp.ParentOptionList, constant.MaxGitCommitParentOptionPopUpWidth)
+			return m, nil
+		}
+	case constant.GitConflictEditorPopUp:
+		// up and down move between the conflict blocks instead of scrolling the preview
+		switch msg.String() {
+		case "up", "k":
+			resolvePopUp.MoveGitConflictEditorBlock(m, -1)
+		case "down", "j":
+			resolvePopUp.MoveGitConflictEditorBlock(m, 1)
+		}
+		return m, nil
 
 	// following is for viewport
 	case constant.GlobalKeyBindingPopUp:
@@ -179,6 +395,12 @@ func UpDownKeyMsgUpdateForPopUp(msg tea.KeyMsg, m *types.GittiModel) (*types.Git
 			popUp.GitPullOutputViewport, cmd = popUp.GitPullOutputViewport.Update(msg)
 			return m, cmd
 		}
+	case constant.GitRepoOperationOutputPopUp:
+		popUp, ok := m.PopUpModel.(*operationPopUp.GitRepoOperationOutputPopUpModel)
+		if ok {
+			popUp.GitRepoOperationOutputViewport, cmd = popUp.GitRepoOperationOutputViewport.Update(msg)
+			return m, cmd
+		}
 	case constant.SwitchBranchOutputPopUp:
 		popUp, ok := m.PopUpModel.(*branchPopUp.SwitchBranchOutputPopUpModel)
 		if ok {
@@ -212,6 +434,13 @@ func UpDownMouseMsgUpdateForPopUp(msg tea.MouseMsg, m *types.GittiModel) (*types
 			return m, cmd
 		}
 
+	case constant.GitConflictEditorPopUp:
+		popUp, ok := m.PopUpModel.(*resolvePopUp.GitConflictEditorPopUpModel)
+		if ok {
+			popUp.ConflictEditorViewport, cmd = popUp.ConflictEditorViewport.Update(msg)
+			return m, cmd
+		}
+
 	case constant.CommitPopUp:
 		popUp, ok := m.PopUpModel.(*commitPopUp.GitCommitPopUpModel)
 		if ok {
@@ -236,6 +465,12 @@ func UpDownMouseMsgUpdateForPopUp(msg tea.MouseMsg, m *types.GittiModel) (*types
 			popUp.GitPullOutputViewport, cmd = popUp.GitPullOutputViewport.Update(msg)
 			return m, cmd
 		}
+	case constant.GitRepoOperationOutputPopUp:
+		popUp, ok := m.PopUpModel.(*operationPopUp.GitRepoOperationOutputPopUpModel)
+		if ok {
+			popUp.GitRepoOperationOutputViewport, cmd = popUp.GitRepoOperationOutputViewport.Update(msg)
+			return m, cmd
+		}
 	case constant.SwitchBranchOutputPopUp:
 		popUp, ok := m.PopUpModel.(*branchPopUp.SwitchBranchOutputPopUpModel)
 		if ok {
@@ -258,3 +493,65 @@ func UpDownMouseMsgUpdateForPopUp(msg tea.MouseMsg, m *types.GittiModel) (*types
 
 	return m, nil
 }
+
+func toggleListItemMark(markedItems map[string]bool, key string) {
+	if markedItems[key] {
+		delete(markedItems, key)
+	} else {
+		markedItems[key] = true
+	}
+}
+
+// return the marked items of a list component in the order they are listed, along with the name to be shown to user for each of them
+func markedListItems(m *types.GittiModel, component string) ([]string, []string) {
+	items := []string{}
+	itemsDisplayName := []string{}
+	switch component {
+	case constant.LocalBranchComponent:
+		for _, item := range m.CurrentRepoBranchesInfoList.Items() {
+			if branchItem, ok := item.(branch.GitBranchItem); ok && m.ListMarkedItems.LocalBranchComponent[branchItem.BranchName] {
+				items = append(items, branchItem.BranchName)
+				itemsDisplayName = append(itemsDisplayName, branchItem.BranchName)
+			}
+		}
+	case constant.ModifiedFilesComponent:
+		// the files under a collapsed directory are not within the list items in tree view, so go through the files status instead
+		for _, file := range m.GitOperations.GitFiles.FilesStatus() {
+			if m.ListMarkedItems.ModifiedFilesComponent[file.FilePathname] {
+				items = append(items, file.FilePathname)
+				itemsDisplayName = append(itemsDisplayName, file.DisplayPathname())
+			}
+		}
+	case constant.StashComponent:
+		for _, item := range m.CurrentRepoStashInfoList.Items() {
+			if stashItem, ok := item.(stash.GitStashItem); ok && m.ListMarkedItems.StashComponent[stashItem.Hash] {
+				items = append(items, stashItem.Id)
+				itemsDisplayName = append(itemsDisplayName, stashItem.Id+": "+stashItem.Message)
+			}
+		}
+	}
+	return items, itemsDisplayName
+}
+
+// the selected component shows a diff on the detail component panel, the diff options only take effect on them
+func isDiffComponentSelected(m *types.GittiModel) bool {
+	switch m.CurrentSelectedComponent {
+	case constant.ModifiedFilesComponent, constant.CommitLogComponent, constant.StashComponent, constant.DetailComponent, constant.DetailComponentTwo:
+		return true
+	}
+	return false
+}
+
+// the detail component panel is showing a paged diff of the selected modified file
+func isDetailPanelDiffPaged(m *types.GittiModel) bool {
+	if !m.DetailPanelDiffPage.IsPaged {
+		return false
+	}
+	switch m.CurrentSelectedComponent {
+	case constant.ModifiedFilesComponent:
+		return true
+	case constant.DetailComponent, constant.DetailComponentTwo:
+		return m.DetailPanelParentComponent == constant.ModifiedFilesComponent
+	}
+	return false
+}
@@ -35,13 +35,18 @@ func GittiKeyInteraction(msg tea.KeyMsg, m *types.GittiModel) (*types.GittiModel
 		utils.OpenBrowser(constant.AUTHOR_LINKEDIN)
 		return m, nil
 	case "-":
-		m.WindowLeftPanelRatio = max(settings.MINLEFTPANELWIDTHRATIO, m.WindowLeftPanelRatio-0.01)
-		layout.TuiWindowSizing(m)
-		return m, nil
+		// let it through while typing, branch names and file patterns will often contain it
+		if !m.IsTyping.Load() {
+			m.WindowLeftPanelRatio = max(settings.MINLEFTPANELWIDTHRATIO, m.WindowLeftPanelRatio-0.01)
+			layout.TuiWindowSizing(m)
+			return m, nil
+		}
 	case "+":
-		m.WindowLeftPanelRatio = min(settings.MAXLEFTPANELWIDTHRATIO, m.WindowLeftPanelRatio+0.01)
-		layout.TuiWindowSizing(m)
-		return m, nil
+		if !m.IsTyping.Load() {
+			m.WindowLeftPanelRatio = min(settings.MAXLEFTPANELWIDTHRATIO, m.WindowLeftPanelRatio+0.01)
+			layout.TuiWindowSizing(m)
+			return m, nil
+		}
 	}
 
 	if m.IsTyping.Load() {
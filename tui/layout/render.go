@@ -10,7 +10,9 @@ import (
 	branchComponent "github.com/gohyuhan/gitti/tui/component/branch"
 	filesComponent "github.com/gohyuhan/gitti/tui/component/files"
 	"github.com/gohyuhan/gitti/tui/constant"
+	batchPopUp "github.com/gohyuhan/gitti/tui/popup/batch"
 	branchPopUp "github.com/gohyuhan/gitti/tui/popup/branch"
+	operationPopUp "github.com/gohyuhan/gitti/tui/popup/operation"
 	stashPopUp "github.com/gohyuhan/gitti/tui/popup/stash"
 	"github.com/gohyuhan/gitti/tui/style"
 	"github.com/gohyuhan/gitti/tui/types"
@@ -52,6 +54,12 @@ func renderGitStatusComponentPanel(m *types.GittiModel) string {
 		trackedUpStreamOrBranchName = m.BranchUpStream
 	}
 
+	// the merge, rebase, cherry-pick, revert or bisect that the repo is in the middle of will be shown in front of the repo name
+	if repoOperationStateName := operationPopUp.RepoOperationStateName(m.RepoOperationState); repoOperationStateName != "" {
+		remoteSyncStateLineString += " " + style.RepoOperationStateStyle.Render(repoOperationStateName)
+		additionalWidth += 1 + lipgloss.Width(repoOperationStateName)
+	}
+
 	repoTrackBranchName := fmt.Sprintf(" %s -> %s %s", m.RepoName, m.TrackedUpstreamOrBranchIcon, trackedUpStreamOrBranchName)
 
 	// the max width is the window width - padding - the length of RemoteSyncStateLineString
@@ -93,10 +101,14 @@ func renderCommitLogComponentPanel(width int, height int, m *types.GittiModel) s
 	if m.CurrentSelectedComponent == constant.CommitLogComponent {
 		borderStyle = style.SelectedBorderStyle
 	}
+	commitLogList := m.CurrentRepoCommitLogInfoList
+	if m.IsCommitLogFilesView {
+		commitLogList = m.CurrentRepoCommitLogFilesInfoList
+	}
 	return borderStyle.
 		Width(width).
 		Height(height).
-		Render(strings.ReplaceAll(m.CurrentRepoCommitLogInfoList.View(), "No items.", ""))
+		Render(strings.ReplaceAll(commitLogList.View(), "No items.", ""))
 }
 
 // Render the detail component part at the right of the window,
@@ -213,6 +225,48 @@ func renderKeyBindingComponentPanel(width int, m *types.GittiModel) string {
 			}
 		case constant.GitStashConfirmPromptPopUp:
 			keys = i18n.LANGUAGEMAPPING.KeyBindingForGitStashConfirmPromptPopUp
+		case constant.GitConflictEditorPopUp:
+			keys = i18n.LANGUAGEMAPPING.KeyBindingForGitConflictEditorPopUp
+		case constant.GitMergeToolResultPopUp:
+			keys = i18n.LANGUAGEMAPPING.KeyBindingForGitMergeToolResultPopUp
+		case constant.GitRepoOperationOptionPopUp:
+			keys = i18n.LANGUAGEMAPPING.KeyBindingForGitRepoOperationOptionPopUp
+		case constant.GitPathspecPopUp:
+			keys = i18n.LANGUAGEMAPPING.KeyBindingForGitPathspecPopUp
+		case constant.GitPathspecActionOptionPopUp:
+			keys = i18n.LANGUAGEMAPPING.KeyBindingForGitPathspecActionOptionPopUp
+		case constant.GitIgnoreRuleOptionPopUp:
+			keys = i18n.LANGUAGEMAPPING.KeyBindingForGitIgnoreRuleOptionPopUp
+		case constant.GitCleanPopUp:
+			keys = i18n.LANGUAGEMAPPING.KeyBindingForGitCleanPopUp
+		case constant.GitIgnoreTargetOptionPopUp:
+			keys = i18n.LANGUAGEMAPPING.KeyBindingForGitIgnoreTargetOptionPopUp
+		case constant.GitRestoreSourcePopUp:
+			keys = i18n.LANGUAGEMAPPING.KeyBindingForGitRestoreSourcePopUp
+		case constant.GitRestoreFilePopUp:
+			keys = i18n.LANGUAGEMAPPING.KeyBindingForGitRestoreFilePopUp
+		case constant.GitRestoreTargetOptionPopUp:
+			keys = i18n.LANGUAGEMAPPING.KeyBindingForGitRestoreTargetOptionPopUp
+		case constant.GitRestoreConfirmPromptPopUp:
+			keys = i18n.LANGUAGEMAPPING.KeyBindingForGitRestoreConfirmPromptPopUp
+		case constant.GitCommitLogFilterPopUp:
+			keys = i18n.LANGUAGEMAPPING.KeyBindingForGitCommitLogFilterPopUp
+		case constant.GitCommitLogScopeOptionPopUp:
+			keys = i18n.LANGUAGEMAPPING.KeyBindingForGitCommitLogScopeOptionPopUp
+		case constant.GitCommitLogScopeBranchesPopUp:
+			keys = i18n.LANGUAGEMAPPING.KeyBindingForGitCommitLogScopeBranchesPopUp
+		case constant.GitCommitParentOptionPopUp:
+			keys = i18n.LANGUAGEMAPPING.KeyBindingForGitCommitParentOptionPopUp
+		case constant.GitApplyPatchErrorPopUp:
+			keys = i18n.LANGUAGEMAPPING.KeyBindingForGitApplyPatchErrorPopUp
+		case constant.GitRepoOperationOutputPopUp:
+			keys = i18n.LANGUAGEMAPPING.KeyBindingForGitRepoOperationOutputPopUp
+			popUp, ok := m.PopUpModel.(*operationPopUp.GitRepoOperationOutputPopUpModel)
+			if ok {
+				if popUp.IsProcessing.Load() {
+					keys = []string{"..."} // nothing can be done during the operation, only force quit gitti is possible
+				}
+			}
 		case constant.GitDeleteBranchConfirmPromptPopUp:
 			keys = i18n.LANGUAGEMAPPING.KeyBindingForGitDeleteBranchConfirmPromptPopUp
 		case constant.GitDeleteBranchOutputPopUp:
@@ -233,6 +287,16 @@ func renderKeyBindingComponentPanel(width int, m *types.GittiModel) string {
 					keys = []string{"..."} // nothing can be done during stash operation, only force quit gitti is possible
 				}
 			}
+		case constant.GitBatchOperationConfirmPromptPopUp:
+			keys = i18n.LANGUAGEMAPPING.KeyBindingForGitBatchOperationConfirmPromptPopUp
+		case constant.GitBatchOperationOutputPopUp:
+			keys = i18n.LANGUAGEMAPPING.KeyBindingForGitBatchOperationOutputPopUp
+			popUp, ok := m.PopUpModel.(*batchPopUp.GitBatchOperationOutputPopUpModel)
+			if ok {
+				if popUp.IsProcessing.Load() {
+					keys = []string{"..."} // nothing can be done during batch operation, only force quit gitti is possible
+				}
+			}
 
 		}
 	} else {
@@ -248,6 +312,8 @@ func renderKeyBindingComponentPanel(width int, m *types.GittiModel) string {
 			CurrentSelectedBranch := m.CurrentRepoBranchesInfoList.SelectedItem()
 			if CurrentSelectedBranch == nil {
 				keys = i18n.LANGUAGEMAPPING.KeyBindingLocalBranchComponentNone
+			} else if len(m.ListMarkedItems.LocalBranchComponent) > 0 {
+				keys = i18n.LANGUAGEMAPPING.KeyBindingLocalBranchComponentMarked
 			} else {
 				isCurrentSelectedBranchCheckedOutBranch := CurrentSelectedBranch.(branchComponent.GitBranchItem).IsCheckedOut
 				if isCurrentSelectedBranchCheckedOutBranch {
@@ -260,10 +326,17 @@ func renderKeyBindingComponentPanel(width int, m *types.GittiModel) string {
 			CurrentSelectedFile := m.CurrentRepoModifiedFilesInfoList.SelectedItem()
 			if CurrentSelectedFile == nil {
 				keys = i18n.LANGUAGEMAPPING.KeyBindingModifiedFilesComponentNone
+			} else if len(m.ListMarkedItems.ModifiedFilesComponent) > 0 {
+				keys = i18n.LANGUAGEMAPPING.KeyBindingModifiedFilesComponentMarked
+			} else if _, isDirectory := CurrentSelectedFile.(filesComponent.GitModifiedFilesDirectoryItem); isDirectory {
+				keys = i18n.LANGUAGEMAPPING.KeyBindingModifiedFilesComponentDirectory
 			} else {
 				file := CurrentSelectedFile.(filesComponent.GitModifiedFilesItem)
 				if file.HasConflict {
 					keys = i18n.LANGUAGEMAPPING.KeyBindingModifiedFilesComponentConflict
+				} else if file.IndexState == "!" {
+					// ignored, only shown for inspection
+					keys = i18n.LANGUAGEMAPPING.KeyBindingModifiedFilesComponentIgnored
 				} else {
 					if file.IndexState == "?" && file.WorkTree == "?" {
 						// not tracked
@@ -282,12 +355,35 @@ func renderKeyBindingComponentPanel(width int, m *types.GittiModel) string {
 			}
 		case constant.CommitLogComponent:
 			keys = i18n.LANGUAGEMAPPING.KeyBindingCommitLogComponent
+			if !m.GitOperations.GitCommitLog.CommitLogFilter().IsEmpty() {
+				keys = i18n.LANGUAGEMAPPING.KeyBindingCommitLogComponentFiltered
+			}
+			if m.IsCommitLogFilesView {
+				keys = i18n.LANGUAGEMAPPING.KeyBindingCommitLogComponentFilesView
+			}
 		case constant.DetailComponent:
 			keys = i18n.LANGUAGEMAPPING.KeyBindingKeyDetailComponent
+			if m.DetailPanelDiffCursor != nil && !m.DetailPanelDiffCursor.IsReadOnly && len(m.DetailPanelDiffCursor.Diff.Hunks) > 0 {
+				keys = i18n.LANGUAGEMAPPING.KeyBindingKeyDetailComponentFileDiff
+			}
+			if m.DetailPanelDiffPage.IsPaged && m.DetailPanelParentComponent == constant.ModifiedFilesComponent {
+				keys = i18n.LANGUAGEMAPPING.KeyBindingKeyDetailComponentPagedDiff
+			}
+			if m.DetailPanelBlameCursor != nil && m.DetailPanelParentComponent == constant.ModifiedFilesComponent {
+				keys = i18n.LANGUAGEMAPPING.KeyBindingKeyDetailComponentBlame
+			}
 		case constant.DetailComponentTwo:
 			keys = i18n.LANGUAGEMAPPING.KeyBindingKeyDetailComponent
+			if m.DetailPanelTwoDiffCursor != nil && !m.DetailPanelTwoDiffCursor.IsReadOnly && len(m.DetailPanelTwoDiffCursor.Diff.Hunks) > 0 {
+				keys = i18n.LANGUAGEMAPPING.KeyBindingKeyDetailComponentFileDiff
+			}
+			if m.DetailPanelDiffPage.IsPaged && m.DetailPanelParentComponent == constant.ModifiedFilesComponent {
+				keys = i18n.LANGUAGEMAPPING.KeyBindingKeyDetailComponentPagedDiff
+			}
 		case constant.StashComponent:
-			if len(m.CurrentRepoStashInfoList.Items()) > 0 {
+			if len(m.ListMarkedItems.StashComponent) > 0 {
+				keys = i18n.LANGUAGEMAPPING.KeyBindingKeyStashComponentMarked
+			} else if len(m.CurrentRepoStashInfoList.Items()) > 0 {
 				keys = i18n.LANGUAGEMAPPING.KeyBindingKeyStashComponent
 			} else {
 				keys = i18n.LANGUAGEMAPPING.KeyBindingKeyStashComponentNone
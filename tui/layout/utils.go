@@ -88,6 +88,8 @@ func LeftPanelDynamicResize(m *types.GittiModel) {
 
 	m.CurrentRepoCommitLogInfoList.SetWidth(m.WindowLeftPanelWidth - 2)
 	m.CurrentRepoCommitLogInfoList.SetHeight(m.CommitLogComponentPanelHeight)
+	m.CurrentRepoCommitLogFilesInfoList.SetWidth(m.WindowLeftPanelWidth - 2)
+	m.CurrentRepoCommitLogFilesInfoList.SetHeight(m.CommitLogComponentPanelHeight)
 
 	m.CurrentRepoStashInfoList.SetWidth(m.WindowLeftPanelWidth - 2)
 	m.CurrentRepoStashInfoList.SetHeight(m.StashComponentPanelHeight)
@@ -101,7 +103,8 @@ func UpdateDetailComponentViewportLayout(m *types.GittiModel) {
 		splitHeight := int(m.DetailComponentPanelHeight / 2)
 		splitWidth := int(m.DetailComponentPanelWidth / 2)
 
-		if m.DetailComponentPanelHeight*2 > m.DetailComponentPanelWidth {
+		// side by side diff will always be in horizontal layout
+		if m.DetailComponentPanelHeight*2 > m.DetailComponentPanelWidth && !m.IsDetailPanelSideBySide.Load() {
 			m.DetailComponentPanelLayout = constant.VERTICAL
 			m.DetailPanelViewport.SetHeight(splitHeight - 1)
 			m.DetailPanelViewport.SetWidth(m.DetailComponentPanelWidth - 2)
@@ -120,3 +123,18 @@ func UpdateDetailComponentViewportLayout(m *types.GittiModel) {
 		m.DetailPanelViewport.SetWidth(m.DetailComponentPanelWidth - 2)
 	}
 }
+
+// keep both detail component panel viewports at the same position when a side by side diff is shown,
+// the one that is currently focused will be followed
+func SyncSideBySideDetailPanelViewports(m *types.GittiModel) {
+	if !m.IsDetailPanelSideBySide.Load() {
+		return
+	}
+	if m.CurrentSelectedComponent == constant.DetailComponentTwo {
+		m.DetailPanelViewport.SetYOffset(m.DetailPanelTwoViewport.YOffset())
+		m.DetailPanelViewport.SetXOffset(m.DetailPanelTwoViewport.XOffset())
+	} else {
+		m.DetailPanelTwoViewport.SetYOffset(m.DetailPanelViewport.YOffset())
+		m.DetailPanelTwoViewport.SetXOffset(m.DetailPanelViewport.XOffset())
+	}
+}
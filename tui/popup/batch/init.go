@@ -0,0 +1,42 @@
+package batch
+
+import (
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/viewport"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+)
+
+func InitGitBatchOperationConfirmPromptPopUpModel(m *types.GittiModel, batchOperationType string, items []string, itemsDisplayName []string) {
+	popUpModel := &GitBatchOperationConfirmPromptPopUpModel{
+		BatchOperationType: batchOperationType,
+		Items:              items,
+		ItemsDisplayName:   itemsDisplayName,
+	}
+	m.PopUpModel = popUpModel
+}
+
+func InitGitBatchOperationOutputPopUpModel(m *types.GittiModel, batchOperationType string) {
+	vp := viewport.New()
+	vp.SoftWrap = true
+	vp.MouseWheelEnabled = true
+	vp.MouseWheelDelta = 1
+	vp.SetHeight(constant.PopUpGitBatchOperationOutputViewportHeight)
+	vp.SetWidth(min(constant.MaxGitBatchOperationOutputPopUpWidth, int(float64(m.Width)*0.8)) - 4)
+
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	s.Style = style.SpinnerStyle
+
+	popUpModel := &GitBatchOperationOutputPopUpModel{
+		BatchOperationType:              batchOperationType,
+		GitBatchOperationOutputViewport: vp,
+		Spinner:                         s,
+	}
+	popUpModel.IsProcessing.Store(false)
+	popUpModel.HasError.Store(false)
+	popUpModel.ProcessSuccess.Store(false)
+
+	m.PopUpModel = popUpModel
+}
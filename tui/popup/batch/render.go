@@ -0,0 +1,102 @@
+package batch
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+	"github.com/gohyuhan/gitti/tui/utils"
+
+	"charm.land/lipgloss/v2"
+)
+
+// ------------------------------------
+//
+//	For Git batch operation confirmation prompt
+//
+// ------------------------------------
+func RenderGitBatchOperationConfirmPromptPopUp(m *types.GittiModel) string {
+	popUp, ok := m.PopUpModel.(*GitBatchOperationConfirmPromptPopUpModel)
+	if ok {
+		popUpWidth := min(constant.MaxGitBatchOperationConfirmPromptPopUpWidth, int(float64(m.Width)*0.8))
+
+		var prompt string
+		switch popUp.BatchOperationType {
+		case constant.BATCHDISCARDFILES:
+			prompt = i18n.LANGUAGEMAPPING.GitBatchDiscardFilesConfirmation
+		case constant.BATCHSTASHFILES:
+			prompt = i18n.LANGUAGEMAPPING.GitBatchStashFilesConfirmation
+		case constant.BATCHDELETEBRANCHES:
+			prompt = i18n.LANGUAGEMAPPING.GitBatchDeleteBranchesConfirmation
+		case constant.BATCHDROPSTASHES:
+			prompt = i18n.LANGUAGEMAPPING.GitBatchDropStashesConfirmation
+		}
+
+		var itemLines strings.Builder
+		for index, itemDisplayName := range popUp.ItemsDisplayName {
+			if index >= constant.PopUpGitBatchOperationConfirmItemsMaxHeight {
+				itemLines.WriteString(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitBatchOperationMoreItems, len(popUp.ItemsDisplayName)-index))
+				break
+			}
+			itemLines.WriteString(" - " + style.NewStyle.Foreground(style.ColorYellowWarm).Render(utils.TruncateString(itemDisplayName, popUpWidth-8)) + "\n")
+		}
+
+		content := lipgloss.JoinVertical(
+			lipgloss.Left,
+			style.NewStyle.Render(fmt.Sprintf(prompt, len(popUp.Items))),
+			"",
+			strings.TrimRight(itemLines.String(), "\n"),
+		)
+		return style.PopUpBorderStyle.Width(popUpWidth).Render(content)
+	}
+
+	return ""
+}
+
+// ------------------------------------
+//
+//	For Git batch operation output result
+//
+// ------------------------------------
+func RenderGitBatchOperationOutputPopUp(m *types.GittiModel) string {
+	popUp, ok := m.PopUpModel.(*GitBatchOperationOutputPopUpModel)
+	if ok {
+		popUpWidth := min(constant.MaxGitBatchOperationOutputPopUpWidth, int(float64(m.Width)*0.8))
+
+		outputViewPortStyle := style.PanelBorderStyle.
+			Width(popUpWidth - 2).
+			Height(constant.PopUpGitBatchOperationOutputViewportHeight + 2)
+		if popUp.HasError.Load() {
+			outputViewPortStyle = style.PanelBorderStyle.
+				BorderForeground(style.ColorError)
+		} else if popUp.ProcessSuccess.Load() {
+			outputViewPortStyle = style.PanelBorderStyle.
+				BorderForeground(style.ColorGreenSoft)
+		}
+		popUp.GitBatchOperationOutputViewport.SetWidth(popUpWidth - 4)
+		popUp.GitBatchOperationOutputViewport.SetYOffset(popUp.GitBatchOperationOutputViewport.YOffset())
+		outputViewPort := outputViewPortStyle.Render(popUp.GitBatchOperationOutputViewport.View())
+
+		var content string
+		if popUp.IsProcessing.Load() {
+			processingText := popUp.Spinner.View() + " " + i18n.LANGUAGEMAPPING.GitBatchOperationProcessing
+			content = lipgloss.JoinVertical(
+				lipgloss.Left,
+				i18n.LANGUAGEMAPPING.GitBatchOperationTitle,
+				processingText,
+				outputViewPort,
+			)
+		} else {
+			content = lipgloss.JoinVertical(
+				lipgloss.Left,
+				i18n.LANGUAGEMAPPING.GitBatchOperationTitle,
+				outputViewPort,
+			)
+		}
+		return style.PopUpBorderStyle.Render(content)
+	}
+	return ""
+}
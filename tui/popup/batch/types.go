@@ -0,0 +1,33 @@
+package batch
+
+import (
+	"sync/atomic"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/viewport"
+)
+
+// ---------------------------------
+//
+// for batch operation confirm prompt pop up
+//
+// ---------------------------------
+type GitBatchOperationConfirmPromptPopUpModel struct {
+	BatchOperationType string
+	Items              []string // the file paths, branch names or stash ids the operation will run on
+	ItemsDisplayName   []string // what will be shown to user for each item (eg, stash message instead of stash id)
+}
+
+// ---------------------------------
+//
+// for batch operation output result pop up
+//
+// ---------------------------------
+type GitBatchOperationOutputPopUpModel struct {
+	BatchOperationType              string
+	GitBatchOperationOutputViewport viewport.Model
+	Spinner                         spinner.Model
+	IsProcessing                    atomic.Bool // indicator to prevent multiple thread spawning reacting to the key binding trigger
+	HasError                        atomic.Bool // indicate if any of the operation exitcode is not 0 (meaning have error)
+	ProcessSuccess                  atomic.Bool // has the process sucessfuly executed
+}
@@ -0,0 +1,38 @@
+package clean
+
+import (
+	"charm.land/bubbles/v2/list"
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+	"github.com/gohyuhan/gitti/tui/utils"
+)
+
+// for the clean pop up, start with the plain git clean (untracked files only, ignored files are kept)
+func InitGitCleanPopUpModel(m *types.GittiModel) {
+	checkedEntries := make(map[string]bool)
+
+	width := (min(constant.MaxGitCleanPopUpWidth, int(float64(m.Width)*0.8)) - 4)
+	cEL := list.New([]list.Item{}, GitCleanEntryDelegate{CheckedEntries: checkedEntries}, width, constant.PopUpGitCleanEntryListHeight)
+	cEL.SetShowPagination(false)
+	cEL.SetShowStatusBar(false)
+	cEL.SetFilteringEnabled(false)
+	cEL.SetShowTitle(false)
+
+	// Custom Help Model for Count Display
+	cEL.SetShowHelp(true)
+	cEL.KeyMap = list.KeyMap{}
+	cEL.Styles.HelpStyle = style.NewStyle.MarginTop(0).MarginBottom(0).PaddingTop(0).PaddingBottom(0)
+	cEL.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &cEL, constant.MaxGitCleanPopUpWidth)
+
+	popUpModel := &GitCleanPopUpModel{
+		IncludeDirectories: false,
+		IgnoredMode:        git.CLEANIGNOREDNONE,
+		CheckedEntries:     checkedEntries,
+		CleanEntryList:     cEL,
+	}
+
+	m.PopUpModel = popUpModel
+	RefreshGitCleanPreview(m)
+}
@@ -0,0 +1,61 @@
+package clean
+
+import (
+	"fmt"
+
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+
+	"charm.land/lipgloss/v2"
+)
+
+// ------------------------------------
+//
+//	For previewing and choosing what git clean will remove
+//
+// ------------------------------------
+func RenderGitCleanPopUp(m *types.GittiModel) string {
+	popUp, ok := m.PopUpModel.(*GitCleanPopUpModel)
+	if ok {
+		popUpWidth := min(constant.MaxGitCleanPopUpWidth, int(float64(m.Width)*0.8))
+		title := style.TitleStyle.Render(i18n.LANGUAGEMAPPING.GitCleanTitle)
+
+		directoriesOption := i18n.LANGUAGEMAPPING.GitCleanOptionOff
+		if popUp.IncludeDirectories {
+			directoriesOption = i18n.LANGUAGEMAPPING.GitCleanOptionOn
+		}
+		ignoredOption := i18n.LANGUAGEMAPPING.GitCleanIgnoredKeep
+		switch popUp.IgnoredMode {
+		case git.CLEANIGNOREDINCLUDE:
+			ignoredOption = i18n.LANGUAGEMAPPING.GitCleanIgnoredInclude
+		case git.CLEANIGNOREDONLY:
+			ignoredOption = i18n.LANGUAGEMAPPING.GitCleanIgnoredOnly
+		}
+		options := style.BottomKeyBindingStyle.Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitCleanOptions, directoriesOption, ignoredOption))
+
+		popUp.CleanEntryList.SetWidth(popUpWidth - 4)
+		var entries string
+		if len(popUp.CleanEntryList.Items()) < 1 {
+			entries = style.NewStyle.Faint(true).Render(i18n.LANGUAGEMAPPING.GitCleanNothingToClean)
+		} else {
+			entries = lipgloss.JoinVertical(
+				lipgloss.Left,
+				style.NewStyle.Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitCleanCheckedCount, len(popUp.CheckedEntries), len(popUp.CleanEntryList.Items()))),
+				popUp.CleanEntryList.View(),
+			)
+		}
+
+		content := lipgloss.JoinVertical(
+			lipgloss.Left,
+			title,
+			options,
+			"",
+			entries,
+		)
+		return style.PopUpBorderStyle.Width(popUpWidth).Render(content)
+	}
+	return ""
+}
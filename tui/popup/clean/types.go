@@ -0,0 +1,68 @@
+package clean
+
+import (
+	"fmt"
+	"io"
+
+	"charm.land/bubbles/v2/list"
+	tea "charm.land/bubbletea/v2"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/utils"
+)
+
+// ---------------------------------
+//
+// # A pop up to preview what git clean would remove, only the checked entries will be removed
+//
+// ---------------------------------
+type GitCleanPopUpModel struct {
+	IncludeDirectories bool   // -d
+	IgnoredMode        string // -x or -X
+	CheckedEntries     map[string]bool
+	CleanEntryList     list.Model
+}
+
+// ---------------------------------
+//
+// for the entries of the clean preview
+//
+// ---------------------------------
+type (
+	GitCleanEntryDelegate struct {
+		CheckedEntries map[string]bool
+	}
+	GitCleanEntryItem struct {
+		Entry string
+	}
+)
+
+func (i GitCleanEntryItem) FilterValue() string {
+	return i.Entry
+}
+
+func (d GitCleanEntryDelegate) Height() int                             { return 1 }
+func (d GitCleanEntryDelegate) Spacing() int                            { return 0 }
+func (d GitCleanEntryDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+func (d GitCleanEntryDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	i, ok := listItem.(GitCleanEntryItem)
+	if !ok {
+		return
+	}
+
+	checkbox := "[ ]"
+	if d.CheckedEntries[i.Entry] {
+		checkbox = "[x]"
+	}
+	componentWidth := m.Width() - constant.ListItemOrTitleWidthPad - 2
+	entryStr := utils.TruncateString(fmt.Sprintf("%s %s", checkbox, i.Entry), componentWidth)
+
+	if d.CheckedEntries[i.Entry] {
+		entryStr = style.MarkedItemStyle.Render(entryStr)
+	}
+	if index == m.Index() {
+		fmt.Fprint(w, style.SelectedItemStyle.Render("❯ ")+entryStr)
+	} else {
+		fmt.Fprint(w, style.ItemStyle.Render("  ")+entryStr)
+	}
+}
@@ -0,0 +1,107 @@
+package clean
+
+import (
+	"charm.land/bubbles/v2/list"
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/types"
+	"github.com/gohyuhan/gitti/tui/utils"
+)
+
+// run the dry run again with the current options, the checks of the entries that are no longer listed are dropped
+func RefreshGitCleanPreview(m *types.GittiModel) {
+	popUp, ok := m.PopUpModel.(*GitCleanPopUpModel)
+	if !ok {
+		return
+	}
+
+	entries := m.GitOperations.GitFiles.GitCleanPreview(popUp.IncludeDirectories, popUp.IgnoredMode)
+	items := make([]list.Item, 0, len(entries))
+	stillListed := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		items = append(items, GitCleanEntryItem{Entry: entry})
+		stillListed[entry] = true
+	}
+	for entry := range popUp.CheckedEntries {
+		if !stillListed[entry] {
+			delete(popUp.CheckedEntries, entry)
+		}
+	}
+
+	popUp.CleanEntryList.SetItems(items)
+	if popUp.CleanEntryList.Index() >= len(items) {
+		popUp.CleanEntryList.Select(max(0, len(items)-1))
+	}
+	popUp.CleanEntryList.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &popUp.CleanEntryList, constant.MaxGitCleanPopUpWidth)
+}
+
+// check or uncheck the selected entry
+func ToggleGitCleanEntry(m *types.GittiModel) {
+	popUp, ok := m.PopUpModel.(*GitCleanPopUpModel)
+	if !ok {
+		return
+	}
+	selectedItem, ok := popUp.CleanEntryList.SelectedItem().(GitCleanEntryItem)
+	if !ok {
+		return
+	}
+	if popUp.CheckedEntries[selectedItem.Entry] {
+		delete(popUp.CheckedEntries, selectedItem.Entry)
+	} else {
+		popUp.CheckedEntries[selectedItem.Entry] = true
+	}
+}
+
+// check every entry, or uncheck all of them when they are all checked already
+func ToggleAllGitCleanEntries(m *types.GittiModel) {
+	popUp, ok := m.PopUpModel.(*GitCleanPopUpModel)
+	if !ok {
+		return
+	}
+	items := popUp.CleanEntryList.Items()
+	if len(popUp.CheckedEntries) == len(items) {
+		clear(popUp.CheckedEntries)
+		return
+	}
+	for _, item := range items {
+		if cleanEntryItem, ok := item.(GitCleanEntryItem); ok {
+			popUp.CheckedEntries[cleanEntryItem.Entry] = true
+		}
+	}
+}
+
+// include or leave out the untracked directories (-d)
+func ToggleGitCleanDirectories(m *types.GittiModel) {
+	popUp, ok := m.PopUpModel.(*GitCleanPopUpModel)
+	if ok {
+		popUp.IncludeDirectories = !popUp.IncludeDirectories
+		RefreshGitCleanPreview(m)
+	}
+}
+
+// keep the ignored files -> include them (-x) -> only them (-X)
+func CycleGitCleanIgnoredMode(m *types.GittiModel) {
+	popUp, ok := m.PopUpModel.(*GitCleanPopUpModel)
+	if ok {
+		switch popUp.IgnoredMode {
+		case git.CLEANIGNOREDNONE:
+			popUp.IgnoredMode = git.CLEANIGNOREDINCLUDE
+		case git.CLEANIGNOREDINCLUDE:
+			popUp.IgnoredMode = git.CLEANIGNOREDONLY
+		default:
+			popUp.IgnoredMode = git.CLEANIGNOREDNONE
+		}
+		RefreshGitCleanPreview(m)
+	}
+}
+
+// the checked entries in the order of the preview
+func CheckedGitCleanEntries(popUp *GitCleanPopUpModel) []string {
+	entries := []string{}
+	for _, item := range popUp.CleanEntryList.Items() {
+		if cleanEntryItem, ok := item.(GitCleanEntryItem); ok && popUp.CheckedEntries[cleanEntryItem.Entry] {
+			entries = append(entries, cleanEntryItem.Entry)
+		}
+	}
+	return entries
+}
@@ -0,0 +1,40 @@
+package commitparent
+
+import (
+	"charm.land/bubbles/v2/list"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+	"github.com/gohyuhan/gitti/tui/utils"
+)
+
+// for the parent option pop up, the first parent (the branch that was merged into) will be selected
+func InitGitCommitParentOptionPopUpModel(m *types.GittiModel, commitHash string) {
+	items := []list.Item{}
+	for index, parent := range m.GitOperations.GitCommitLog.CommitParents(commitHash) {
+		items = append(items, GitCommitParentOptionItem{
+			ParentNumber: index + 1,
+			Parent:       parent,
+		})
+	}
+
+	width := (min(constant.MaxGitCommitParentOptionPopUpWidth, int(float64(m.Width)*0.8)) - 4)
+	pOL := list.New(items, GitCommitParentOptionDelegate{}, width, constant.PopUpGitCommitParentOptionPopUpHeight)
+	pOL.SetShowPagination(false)
+	pOL.SetShowStatusBar(false)
+	pOL.SetFilteringEnabled(false)
+	pOL.SetShowTitle(false)
+
+	// Custom Help Model for Count Display
+	pOL.SetShowHelp(true)
+	pOL.KeyMap = list.KeyMap{}
+	pOL.Styles.HelpStyle = style.NewStyle.MarginTop(0).MarginBottom(0).PaddingTop(0).PaddingBottom(0)
+	pOL.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &pOL, constant.MaxGitCommitParentOptionPopUpWidth)
+
+	popUpModel := &GitCommitParentOptionPopUpModel{
+		CommitHash:       commitHash,
+		ParentOptionList: pOL,
+	}
+
+	m.PopUpModel = popUpModel
+}
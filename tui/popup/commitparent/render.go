@@ -0,0 +1,41 @@
+package commitparent
+
+import (
+	"fmt"
+
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+
+	"charm.land/lipgloss/v2"
+)
+
+// ------------------------------------
+//
+//	For choosing the parent that a merge commit will be diffed against
+//
+// ------------------------------------
+func RenderGitCommitParentOptionPopUp(m *types.GittiModel) string {
+	popUp, ok := m.PopUpModel.(*GitCommitParentOptionPopUpModel)
+	if ok {
+		popUpWidth := min(constant.MaxGitCommitParentOptionPopUpWidth, int(float64(m.Width)*0.8))
+		title := style.TitleStyle.Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitCommitParentOptionTitle, ShortHash(popUp.CommitHash)))
+		popUp.ParentOptionList.SetWidth(popUpWidth - 4)
+		content := lipgloss.JoinVertical(
+			lipgloss.Left,
+			title,
+			popUp.ParentOptionList.View(),
+		)
+		return style.PopUpBorderStyle.Width(popUpWidth).Render(content)
+	}
+	return ""
+}
+
+// the abbreviated commit hash to be shown to the user
+func ShortHash(commitHash string) string {
+	if len(commitHash) > 7 {
+		return commitHash[:7]
+	}
+	return commitHash
+}
@@ -0,0 +1,77 @@
+package commitparent
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"charm.land/bubbles/v2/list"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/utils"
+)
+
+// ---------------------------------
+//
+// choose the parent that the changed files of a merge commit will be diffed against
+//
+// ---------------------------------
+type GitCommitParentOptionPopUpModel struct {
+	CommitHash       string
+	ParentOptionList list.Model
+}
+
+// ---------------------------------
+//
+// for parent selection option
+//
+// ---------------------------------
+type (
+	GitCommitParentOptionDelegate struct{}
+	GitCommitParentOptionItem     struct {
+		ParentNumber int // 1 based, as in <commit>^<n>
+		Parent       git.CommitParent
+	}
+)
+
+func (i GitCommitParentOptionItem) FilterValue() string {
+	return i.Parent.Hash
+}
+
+// for parent selection, one line for each as an octopus merge can have many of them
+func (d GitCommitParentOptionDelegate) Height() int                             { return 1 }
+func (d GitCommitParentOptionDelegate) Spacing() int                            { return 0 }
+func (d GitCommitParentOptionDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+func (d GitCommitParentOptionDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	i, ok := listItem.(GitCommitParentOptionItem)
+	if !ok {
+		return
+	}
+
+	componentWidth := m.Width() - constant.ListItemOrTitleWidthPad - 2
+
+	nameStr := fmt.Sprintf(" [%s] %s", fmt.Sprintf(i18n.LANGUAGEMAPPING.GitCommitParentOptionName, i.ParentNumber), ShortHash(i.Parent.Hash))
+	nameStr = utils.TruncateString(nameStr, componentWidth)
+	infoStr := utils.TruncateString("  "+i.Parent.Message, max(componentWidth-lipgloss.Width(nameStr), 0))
+
+	nameRendered := style.ItemStyle.Render(nameStr)
+	infoRendered := style.ItemStyle.Faint(true).Render(infoStr)
+	fullStr := nameRendered + infoRendered
+
+	var fn func(...string) string
+	if index == m.Index() {
+		fn = func(s ...string) string {
+			return style.SelectedItemStyle.Render("❯ " + strings.Join(s, " "))
+		}
+	} else {
+		fn = func(s ...string) string {
+			return style.ItemStyle.Render("  " + strings.Join(s, " "))
+		}
+	}
+
+	fmt.Fprint(w, fn(fullStr))
+}
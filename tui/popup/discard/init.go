@@ -91,3 +91,16 @@ func InitGitDiscardConfirmPromptPopupModel(m *types.GittiModel, filePathName str
 	}
 	m.PopUpModel = popUpModel
 }
+
+// for partial (hunk/line) discard confirm prompt
+func InitGitDiscardPartialConfirmPromptPopupModel(m *types.GittiModel, filePathName string, fileDiff git.FileDiff, selectedLines []git.DiffLinePosition, includeIndex bool, previewLines []string) {
+	popUpModel := &GitDiscardConfirmPromptPopUpModel{
+		FilePathName:  filePathName,
+		DiscardType:   git.DISCARDPARTIAL,
+		FileDiff:      fileDiff,
+		SelectedLines: selectedLines,
+		IncludeIndex:  includeIndex,
+		PreviewLines:  previewLines,
+	}
+	m.PopUpModel = popUpModel
+}
@@ -54,8 +54,34 @@ func RenderGitDiscardConfirmPromptPopup(m *types.GittiModel) string {
 			content = style.NewStyle.Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitDiscardNewlyAddedorCopyConfirmation, popUp.FilePathName))
 		case git.DISCARDANDREVERTRENAME:
 			content = style.NewStyle.Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitDiscardAndRevertRenameConfirmation, popUp.FilePathName))
+		case git.DISCARDDIRECTORY:
+			content = style.NewStyle.Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitDiscardDirectoryConfirmation, popUp.FilePathName))
+		case git.DISCARDPARTIAL:
+			content = lipgloss.JoinVertical(
+				lipgloss.Left,
+				style.NewStyle.Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitDiscardPartialConfirmation, popUp.FilePathName)),
+				"",
+				renderGitDiscardPartialPreview(popUp.PreviewLines, popUpWidth-2),
+			)
 		}
 		return style.PopUpBorderStyle.Width(popUpWidth).Render(content)
 	}
 	return ""
 }
+
+// the preview of the hunk/line(s) that will be discarded, capped to a fixed height
+func renderGitDiscardPartialPreview(previewLines []string, width int) string {
+	lines := previewLines
+	if len(lines) > constant.PopUpGitDiscardPartialPreviewMaxHeight {
+		lines = append(lines[:constant.PopUpGitDiscardPartialPreviewMaxHeight:constant.PopUpGitDiscardPartialPreviewMaxHeight], "...")
+	}
+	previewStyle := style.PanelBorderStyle.Width(width)
+	var preview string
+	for index, line := range lines {
+		if index > 0 {
+			preview += "\n"
+		}
+		preview += style.NewStyle.MaxWidth(width - 4).Render(line)
+	}
+	return previewStyle.Render(preview)
+}
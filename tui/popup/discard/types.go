@@ -7,6 +7,7 @@ import (
 
 	"charm.land/bubbles/v2/list"
 	tea "charm.land/bubbletea/v2"
+	"github.com/gohyuhan/gitti/api/git"
 	"github.com/gohyuhan/gitti/tui/constant"
 	"github.com/gohyuhan/gitti/tui/style"
 	"github.com/gohyuhan/gitti/tui/utils"
@@ -32,6 +33,11 @@ type GitDiscardTypeOptionPopUpModel struct {
 type GitDiscardConfirmPromptPopUpModel struct {
 	DiscardType  string
 	FilePathName string
+	// only for partial (hunk/line) discard
+	FileDiff      git.FileDiff
+	SelectedLines []git.DiffLinePosition
+	IncludeIndex  bool     // the selected changes were also staged, discard them from the index too
+	PreviewLines  []string // the diff lines that will be discarded, shown before confirming
 }
 
 // ---------------------------------
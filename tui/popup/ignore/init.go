@@ -0,0 +1,107 @@
+package ignore
+
+import (
+	"fmt"
+
+	"charm.land/bubbles/v2/list"
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+	"github.com/gohyuhan/gitti/tui/utils"
+)
+
+// for the rule option list of the untracked file, the rule as it would be written to the root .gitignore is shown for each
+func InitGitIgnoreRuleOptionPopUpModel(m *types.GittiModel, filePathName string) {
+	items := []list.Item{}
+	for _, ruleType := range git.IgnoreRuleTypesFor(filePathName) {
+		info := ""
+		if rules := git.IgnoreRulesFor(filePathName, ruleType); len(rules) > 0 {
+			info = rules[0].Rule
+		}
+		items = append(items, GitIgnoreRuleOptionItem{
+			Name:     IgnoreRuleTypeName(ruleType),
+			Info:     info,
+			RuleType: ruleType,
+		})
+	}
+
+	width := (min(constant.MaxGitIgnoreRuleOptionPopUpWidth, int(float64(m.Width)*0.8)) - 4)
+	iROL := list.New(items, GitIgnoreRuleOptionDelegate{}, width, constant.PopUpGitIgnoreRuleOptionPopUpHeight)
+	iROL.SetShowPagination(false)
+	iROL.SetShowStatusBar(false)
+	iROL.SetFilteringEnabled(false)
+	iROL.SetShowTitle(false)
+
+	// Custom Help Model for Count Display
+	iROL.SetShowHelp(true)
+	iROL.KeyMap = list.KeyMap{}
+	iROL.Styles.HelpStyle = style.NewStyle.MarginTop(0).MarginBottom(0).PaddingTop(0).PaddingBottom(0)
+	iROL.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &iROL, constant.MaxGitIgnoreRuleOptionPopUpWidth)
+
+	popUpModel := &GitIgnoreRuleOptionPopUpModel{
+		FilePathName:         filePathName,
+		IgnoreRuleOptionList: iROL,
+	}
+
+	m.PopUpModel = popUpModel
+}
+
+// for the target option list of the chosen rule, each with a preview of the line that will be written
+func InitGitIgnoreTargetOptionPopUpModel(m *types.GittiModel, filePathName string, ruleType string) {
+	items := []list.Item{}
+	for _, ignoreRule := range git.IgnoreRulesFor(filePathName, ruleType) {
+		items = append(items, GitIgnoreTargetOptionItem{
+			Name:       IgnoreTargetName(ignoreRule.TargetType),
+			Info:       fmt.Sprintf(i18n.LANGUAGEMAPPING.GitIgnoreRulePreview, ignoreRule.Rule, ignoreRule.TargetPath),
+			IgnoreRule: ignoreRule,
+		})
+	}
+
+	width := (min(constant.MaxGitIgnoreTargetOptionPopUpWidth, int(float64(m.Width)*0.8)) - 4)
+	iTOL := list.New(items, GitIgnoreTargetOptionDelegate{}, width, constant.PopUpGitIgnoreTargetOptionPopUpHeight)
+	iTOL.SetShowPagination(false)
+	iTOL.SetShowStatusBar(false)
+	iTOL.SetFilteringEnabled(false)
+	iTOL.SetShowTitle(false)
+
+	// Custom Help Model for Count Display
+	iTOL.SetShowHelp(true)
+	iTOL.KeyMap = list.KeyMap{}
+	iTOL.Styles.HelpStyle = style.NewStyle.MarginTop(0).MarginBottom(0).PaddingTop(0).PaddingBottom(0)
+	iTOL.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &iTOL, constant.MaxGitIgnoreTargetOptionPopUpWidth)
+
+	popUpModel := &GitIgnoreTargetOptionPopUpModel{
+		FilePathName:           filePathName,
+		IgnoreTargetOptionList: iTOL,
+	}
+
+	m.PopUpModel = popUpModel
+}
+
+// the name of the rule type to be shown to the user
+func IgnoreRuleTypeName(ruleType string) string {
+	switch ruleType {
+	case git.IGNORERULEPATH:
+		return i18n.LANGUAGEMAPPING.GitIgnoreRulePath
+	case git.IGNORERULEEXTENSION:
+		return i18n.LANGUAGEMAPPING.GitIgnoreRuleExtension
+	case git.IGNORERULEDIRECTORY:
+		return i18n.LANGUAGEMAPPING.GitIgnoreRuleDirectory
+	}
+	return ""
+}
+
+// the name of the ignore file to be shown to the user
+func IgnoreTargetName(targetType string) string {
+	switch targetType {
+	case git.IGNORETARGETROOT:
+		return i18n.LANGUAGEMAPPING.GitIgnoreTargetRoot
+	case git.IGNORETARGETNESTED:
+		return i18n.LANGUAGEMAPPING.GitIgnoreTargetNested
+	case git.IGNORETARGETEXCLUDE:
+		return i18n.LANGUAGEMAPPING.GitIgnoreTargetExclude
+	}
+	return ""
+}
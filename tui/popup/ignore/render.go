@@ -0,0 +1,51 @@
+package ignore
+
+import (
+	"fmt"
+
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+
+	"charm.land/lipgloss/v2"
+)
+
+// ------------------------------------
+//
+//	For ignoring an untracked file
+//
+// ------------------------------------
+// choose what the rule will cover
+func RenderGitIgnoreRuleOptionPopUp(m *types.GittiModel) string {
+	popUp, ok := m.PopUpModel.(*GitIgnoreRuleOptionPopUpModel)
+	if ok {
+		popUpWidth := min(constant.MaxGitIgnoreRuleOptionPopUpWidth, int(float64(m.Width)*0.8))
+		title := style.TitleStyle.Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitIgnoreRuleOptionTitle, popUp.FilePathName))
+		popUp.IgnoreRuleOptionList.SetWidth(popUpWidth - 4)
+		content := lipgloss.JoinVertical(
+			lipgloss.Left,
+			title,
+			popUp.IgnoreRuleOptionList.View(),
+		)
+		return style.PopUpBorderStyle.Width(popUpWidth).Render(content)
+	}
+	return ""
+}
+
+// choose the file the rule will be written to
+func RenderGitIgnoreTargetOptionPopUp(m *types.GittiModel) string {
+	popUp, ok := m.PopUpModel.(*GitIgnoreTargetOptionPopUpModel)
+	if ok {
+		popUpWidth := min(constant.MaxGitIgnoreTargetOptionPopUpWidth, int(float64(m.Width)*0.8))
+		title := style.TitleStyle.Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitIgnoreTargetOptionTitle, popUp.FilePathName))
+		popUp.IgnoreTargetOptionList.SetWidth(popUpWidth - 4)
+		content := lipgloss.JoinVertical(
+			lipgloss.Left,
+			title,
+			popUp.IgnoreTargetOptionList.View(),
+		)
+		return style.PopUpBorderStyle.Width(popUpWidth).Render(content)
+	}
+	return ""
+}
@@ -0,0 +1,142 @@
+package ignore
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"charm.land/bubbles/v2/list"
+	tea "charm.land/bubbletea/v2"
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/utils"
+)
+
+// ---------------------------------
+//
+// choose what the ignore rule of the untracked file will cover (the path, the extension or the directory)
+//
+// ---------------------------------
+type GitIgnoreRuleOptionPopUpModel struct {
+	FilePathName         string
+	IgnoreRuleOptionList list.Model
+}
+
+// ---------------------------------
+//
+// choose the file that the ignore rule will be written to, with the rule previewed for each of them
+//
+// ---------------------------------
+type GitIgnoreTargetOptionPopUpModel struct {
+	FilePathName           string
+	IgnoreTargetOptionList list.Model
+}
+
+// ---------------------------------
+//
+// for ignore rule selection option
+//
+// ---------------------------------
+type (
+	GitIgnoreRuleOptionDelegate struct{}
+	GitIgnoreRuleOptionItem     struct {
+		Name     string
+		Info     string
+		RuleType string
+	}
+)
+
+func (i GitIgnoreRuleOptionItem) FilterValue() string {
+	return i.Name
+}
+
+// ---------------------------------
+//
+// for ignore target selection option
+//
+// ---------------------------------
+type (
+	GitIgnoreTargetOptionDelegate struct{}
+	GitIgnoreTargetOptionItem     struct {
+		Name       string
+		Info       string
+		IgnoreRule git.IgnoreRule
+	}
+)
+
+func (i GitIgnoreTargetOptionItem) FilterValue() string {
+	return i.Name
+}
+
+// for ignore rule selection
+func (d GitIgnoreRuleOptionDelegate) Height() int                             { return 1 }
+func (d GitIgnoreRuleOptionDelegate) Spacing() int                            { return 0 }
+func (d GitIgnoreRuleOptionDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+func (d GitIgnoreRuleOptionDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	i, ok := listItem.(GitIgnoreRuleOptionItem)
+	if !ok {
+		return
+	}
+
+	nameStr := fmt.Sprintf("   %s", i.Name)
+	infoStr := fmt.Sprintf("    %s", i.Info)
+
+	componentWidth := m.Width() - constant.ListItemOrTitleWidthPad - 2
+
+	nameStr = utils.TruncateString(nameStr, componentWidth)
+	infoStr = utils.TruncateString(infoStr, componentWidth)
+
+	nameRendered := style.ItemStyle.Render(nameStr)
+	infoRendered := style.ItemStyle.Faint(true).Render(infoStr)
+	fullStr := nameRendered + "\n" + "  " + infoRendered
+
+	var fn func(...string) string
+	if index == m.Index() {
+		fn = func(s ...string) string {
+			return style.SelectedItemStyle.Render("❯ " + strings.Join(s, " "))
+		}
+	} else {
+		fn = func(s ...string) string {
+			return style.ItemStyle.Render("  " + strings.Join(s, " "))
+		}
+	}
+
+	fmt.Fprint(w, fn(fullStr))
+}
+
+// for ignore target selection
+func (d GitIgnoreTargetOptionDelegate) Height() int                             { return 1 }
+func (d GitIgnoreTargetOptionDelegate) Spacing() int                            { return 0 }
+func (d GitIgnoreTargetOptionDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+func (d GitIgnoreTargetOptionDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	i, ok := listItem.(GitIgnoreTargetOptionItem)
+	if !ok {
+		return
+	}
+
+	nameStr := fmt.Sprintf("   %s", i.Name)
+	infoStr := fmt.Sprintf("    %s", i.Info)
+
+	componentWidth := m.Width() - constant.ListItemOrTitleWidthPad - 2
+
+	nameStr = utils.TruncateString(nameStr, componentWidth)
+	infoStr = utils.TruncateString(infoStr, componentWidth)
+
+	nameRendered := style.ItemStyle.Render(nameStr)
+	infoRendered := style.ItemStyle.Faint(true).Render(infoStr)
+	fullStr := nameRendered + "\n" + "  " + infoRendered
+
+	var fn func(...string) string
+	if index == m.Index() {
+		fn = func(s ...string) string {
+			return style.SelectedItemStyle.Render("❯ " + strings.Join(s, " "))
+		}
+	} else {
+		fn = func(s ...string) string {
+			return style.ItemStyle.Render("  " + strings.Join(s, " "))
+		}
+	}
+
+	fmt.Fprint(w, fn(fullStr))
+}
@@ -0,0 +1,48 @@
+package logfilter
+
+import (
+	"charm.land/bubbles/v2/textinput"
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/types"
+)
+
+// init the popup model to filter the commit log, the inputs are filled with the filter that is currently active
+func InitGitCommitLogFilterPopUpModel(m *types.GittiModel) {
+	currentFilter := m.GitOperations.GitCommitLog.CommitLogFilter()
+	values := []string{
+		currentFilter.Message,
+		currentFilter.Author,
+		currentFilter.Since,
+		currentFilter.Until,
+		currentFilter.Path,
+		currentFilter.PickaxeText,
+		currentFilter.PickaxeRegex,
+	}
+	placeholders := []string{
+		i18n.LANGUAGEMAPPING.GitCommitLogFilterRegexPlaceholder,
+		i18n.LANGUAGEMAPPING.GitCommitLogFilterRegexPlaceholder,
+		i18n.LANGUAGEMAPPING.GitCommitLogFilterDatePlaceholder,
+		i18n.LANGUAGEMAPPING.GitCommitLogFilterDatePlaceholder,
+		i18n.LANGUAGEMAPPING.GitCommitLogFilterPathPlaceholder,
+		"",
+		"",
+	}
+
+	filterInputs := make([]textinput.Model, len(values))
+	for index, value := range values {
+		filterInput := textinput.New()
+		filterInput.SetValue(value)
+		filterInput.Placeholder = placeholders[index]
+		filterInput.SetVirtualCursor(true)
+		filterInput.SetWidth(min(constant.MaxGitCommitLogFilterPopUpWidth, int(float64(m.Width)*0.8)) - 4)
+		filterInputs[index] = filterInput
+	}
+
+	popUpModel := &GitCommitLogFilterPopUpModel{
+		FilterInputs:    filterInputs,
+		TotalInputCount: len(filterInputs),
+	}
+	popUpModel.FocusInput(MessageInputIndex)
+	m.PopUpModel = popUpModel
+}
@@ -0,0 +1,44 @@
+package logfilter
+
+import (
+	"charm.land/lipgloss/v2"
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+)
+
+// ------------------------------------
+//
+//	For filtering the commit log
+//
+// ------------------------------------
+func RenderGitCommitLogFilterPopUp(m *types.GittiModel) string {
+	popUp, ok := m.PopUpModel.(*GitCommitLogFilterPopUpModel)
+	if ok {
+		popUpWidth := min(constant.MaxGitCommitLogFilterPopUpWidth, int(float64(m.Width)*0.8))
+		inputTitles := []string{
+			i18n.LANGUAGEMAPPING.GitCommitLogFilterMessageTitle,
+			i18n.LANGUAGEMAPPING.GitCommitLogFilterAuthorTitle,
+			i18n.LANGUAGEMAPPING.GitCommitLogFilterSinceTitle,
+			i18n.LANGUAGEMAPPING.GitCommitLogFilterUntilTitle,
+			i18n.LANGUAGEMAPPING.GitCommitLogFilterPathTitle,
+			i18n.LANGUAGEMAPPING.GitCommitLogFilterPickaxeTextTitle,
+			i18n.LANGUAGEMAPPING.GitCommitLogFilterPickaxeRegexTitle,
+		}
+
+		lines := []string{style.PromptTitleStyle.Render(i18n.LANGUAGEMAPPING.GitCommitLogFilterTitle)}
+		for index := range popUp.FilterInputs {
+			popUp.FilterInputs[index].SetWidth(popUpWidth - 4)
+			lines = append(lines, style.TitleStyle.Render(inputTitles[index]), popUp.FilterInputs[index].View())
+		}
+		if popUp.HasPickaxeConflict {
+			lines = append(lines, style.ErrorStyle.Render(i18n.LANGUAGEMAPPING.GitCommitLogFilterPickaxeConflict))
+		}
+		lines = append(lines, "", style.NewStyle.Faint(true).Render(i18n.LANGUAGEMAPPING.GitCommitLogFilterGraphHint))
+
+		content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+		return style.PopUpBorderStyle.Width(popUpWidth).Render(content)
+	}
+	return ""
+}
@@ -0,0 +1,54 @@
+package logfilter
+
+import (
+	"charm.land/bubbles/v2/textinput"
+	"github.com/gohyuhan/gitti/api/git"
+)
+
+// the order of the inputs within the pop up, the input index is 1 based like the other pop up with multiple inputs
+const (
+	MessageInputIndex = iota + 1
+	AuthorInputIndex
+	SinceInputIndex
+	UntilInputIndex
+	PathInputIndex
+	PickaxeTextInputIndex
+	PickaxeRegexInputIndex
+)
+
+// ---------------------------------
+//
+// # A pop up to filter the commit log by the git log options, each input maps to one option
+//
+// ---------------------------------
+type GitCommitLogFilterPopUpModel struct {
+	FilterInputs            []textinput.Model // FilterInputs[index-1] is the input of the input index
+	TotalInputCount         int               // to tell us how many input were there
+	CurrentActiveInputIndex int               // to tell us which input should be shown as highlighted/focus and be updated
+	HasPickaxeConflict      bool              // both -S and -G were given when the filter was applied
+}
+
+// focus the input of the input index and blur the others
+func (popUp *GitCommitLogFilterPopUpModel) FocusInput(inputIndex int) {
+	popUp.CurrentActiveInputIndex = inputIndex
+	for index := range popUp.FilterInputs {
+		if index+1 == inputIndex {
+			popUp.FilterInputs[index].Focus()
+		} else {
+			popUp.FilterInputs[index].Blur()
+		}
+	}
+}
+
+// the filter from the values of the inputs
+func (popUp *GitCommitLogFilterPopUpModel) Filter() git.CommitLogFilter {
+	return git.CommitLogFilter{
+		Message:      popUp.FilterInputs[MessageInputIndex-1].Value(),
+		Author:       popUp.FilterInputs[AuthorInputIndex-1].Value(),
+		Since:        popUp.FilterInputs[SinceInputIndex-1].Value(),
+		Until:        popUp.FilterInputs[UntilInputIndex-1].Value(),
+		Path:         popUp.FilterInputs[PathInputIndex-1].Value(),
+		PickaxeText:  popUp.FilterInputs[PickaxeTextInputIndex-1].Value(),
+		PickaxeRegex: popUp.FilterInputs[PickaxeRegexInputIndex-1].Value(),
+	}
+}
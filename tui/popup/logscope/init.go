@@ -0,0 +1,106 @@
+package logscope
+
+import (
+	"charm.land/bubbles/v2/list"
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+	"github.com/gohyuhan/gitti/tui/utils"
+)
+
+// for the scope option pop up, the current scope of the commit log will be selected
+func InitGitCommitLogScopeOptionPopUpModel(m *types.GittiModel) {
+	items := []list.Item{
+		GitCommitLogScopeOptionItem{
+			Name: i18n.LANGUAGEMAPPING.CommitLogScopeCurrentBranch,
+			Info: i18n.LANGUAGEMAPPING.CommitLogScopeCurrentBranchInfo,
+			Mode: git.COMMITLOGSCOPECURRENTBRANCH,
+		},
+		GitCommitLogScopeOptionItem{
+			Name: i18n.LANGUAGEMAPPING.CommitLogScopeAllRefs,
+			Info: i18n.LANGUAGEMAPPING.CommitLogScopeAllRefsInfo,
+			Mode: git.COMMITLOGSCOPEALLREFS,
+		},
+		GitCommitLogScopeOptionItem{
+			Name: i18n.LANGUAGEMAPPING.CommitLogScopeSelectedBranches,
+			Info: i18n.LANGUAGEMAPPING.CommitLogScopeSelectedBranchesInfo,
+			Mode: git.COMMITLOGSCOPESELECTEDBRANCHES,
+		},
+		GitCommitLogScopeOptionItem{
+			Name: i18n.LANGUAGEMAPPING.CommitLogScopeFirstParent,
+			Info: i18n.LANGUAGEMAPPING.CommitLogScopeFirstParentInfo,
+			Mode: git.COMMITLOGSCOPEFIRSTPARENT,
+		},
+	}
+
+	width := (min(constant.MaxGitCommitLogScopeOptionPopUpWidth, int(float64(m.Width)*0.8)) - 4)
+	sOL := list.New(items, GitCommitLogScopeOptionDelegate{}, width, constant.PopUpGitCommitLogScopeOptionPopUpHeight)
+	sOL.SetShowPagination(false)
+	sOL.SetShowStatusBar(false)
+	sOL.SetFilteringEnabled(false)
+	sOL.SetShowTitle(false)
+
+	// Custom Help Model for Count Display
+	sOL.SetShowHelp(true)
+	sOL.KeyMap = list.KeyMap{}
+	sOL.Styles.HelpStyle = style.NewStyle.MarginTop(0).MarginBottom(0).PaddingTop(0).PaddingBottom(0)
+
+	currentMode := m.GitOperations.GitCommitLog.CommitLogScope().Mode
+	for index, item := range items {
+		if item.(GitCommitLogScopeOptionItem).Mode == currentMode {
+			sOL.Select(index)
+			break
+		}
+	}
+	sOL.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &sOL, constant.MaxGitCommitLogScopeOptionPopUpWidth)
+
+	popUpModel := &GitCommitLogScopeOptionPopUpModel{
+		ScopeOptionList: sOL,
+	}
+
+	m.PopUpModel = popUpModel
+}
+
+// for the branches pop up, the branches of the current selected branches scope will be checked
+func InitGitCommitLogScopeBranchesPopUpModel(m *types.GittiModel) {
+	checkedBranches := make(map[string]bool)
+	if scope := m.GitOperations.GitCommitLog.CommitLogScope(); scope.Mode == git.COMMITLOGSCOPESELECTEDBRANCHES {
+		for _, branch := range scope.Branches {
+			checkedBranches[branch.FullName()] = true
+		}
+	}
+
+	items := []list.Item{}
+	stillExists := make(map[string]bool)
+	for _, branch := range m.GitOperations.GitCommitLog.CommitLogScopeBranchCandidates() {
+		items = append(items, GitCommitLogScopeBranchItem{Branch: branch})
+		stillExists[branch.FullName()] = true
+	}
+	for fullName := range checkedBranches {
+		if !stillExists[fullName] {
+			delete(checkedBranches, fullName)
+		}
+	}
+
+	width := (min(constant.MaxGitCommitLogScopeBranchesPopUpWidth, int(float64(m.Width)*0.8)) - 4)
+	bL := list.New(items, GitCommitLogScopeBranchDelegate{CheckedBranches: checkedBranches}, width, constant.PopUpGitCommitLogScopeBranchListHeight)
+	bL.SetShowPagination(false)
+	bL.SetShowStatusBar(false)
+	bL.SetFilteringEnabled(false)
+	bL.SetShowTitle(false)
+
+	// Custom Help Model for Count Display
+	bL.SetShowHelp(true)
+	bL.KeyMap = list.KeyMap{}
+	bL.Styles.HelpStyle = style.NewStyle.MarginTop(0).MarginBottom(0).PaddingTop(0).PaddingBottom(0)
+	bL.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &bL, constant.MaxGitCommitLogScopeBranchesPopUpWidth)
+
+	popUpModel := &GitCommitLogScopeBranchesPopUpModel{
+		CheckedBranches: checkedBranches,
+		BranchList:      bL,
+	}
+
+	m.PopUpModel = popUpModel
+}
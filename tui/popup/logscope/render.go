@@ -0,0 +1,67 @@
+package logscope
+
+import (
+	"fmt"
+
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+
+	"charm.land/lipgloss/v2"
+)
+
+// ------------------------------------
+//
+//	For choosing the history that the commit log graph is drawn for
+//
+// ------------------------------------
+func RenderGitCommitLogScopeOptionPopUp(m *types.GittiModel) string {
+	popUp, ok := m.PopUpModel.(*GitCommitLogScopeOptionPopUpModel)
+	if ok {
+		popUpWidth := min(constant.MaxGitCommitLogScopeOptionPopUpWidth, int(float64(m.Width)*0.8))
+		title := style.TitleStyle.Render(i18n.LANGUAGEMAPPING.GitCommitLogScopeOptionTitle)
+		popUp.ScopeOptionList.SetWidth(popUpWidth - 4)
+		content := lipgloss.JoinVertical(
+			lipgloss.Left,
+			title,
+			popUp.ScopeOptionList.View(),
+		)
+		return style.PopUpBorderStyle.Width(popUpWidth).Render(content)
+	}
+	return ""
+}
+
+// ------------------------------------
+//
+//	For choosing the branches of the selected branches scope
+//
+// ------------------------------------
+func RenderGitCommitLogScopeBranchesPopUp(m *types.GittiModel) string {
+	popUp, ok := m.PopUpModel.(*GitCommitLogScopeBranchesPopUpModel)
+	if ok {
+		popUpWidth := min(constant.MaxGitCommitLogScopeBranchesPopUpWidth, int(float64(m.Width)*0.8))
+		title := style.TitleStyle.Render(i18n.LANGUAGEMAPPING.GitCommitLogScopeBranchesTitle)
+
+		popUp.BranchList.SetWidth(popUpWidth - 4)
+		var branches string
+		if len(popUp.BranchList.Items()) < 1 {
+			branches = style.NewStyle.Faint(true).Render(i18n.LANGUAGEMAPPING.GitCommitLogScopeNoBranch)
+		} else {
+			branches = lipgloss.JoinVertical(
+				lipgloss.Left,
+				style.NewStyle.Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitCommitLogScopeBranchesCheckedCount, len(popUp.CheckedBranches), len(popUp.BranchList.Items()))),
+				popUp.BranchList.View(),
+			)
+		}
+
+		content := lipgloss.JoinVertical(
+			lipgloss.Left,
+			title,
+			"",
+			branches,
+		)
+		return style.PopUpBorderStyle.Width(popUpWidth).Render(content)
+	}
+	return ""
+}
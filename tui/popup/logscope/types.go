@@ -0,0 +1,133 @@
+package logscope
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"charm.land/bubbles/v2/list"
+	tea "charm.land/bubbletea/v2"
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/utils"
+)
+
+// ---------------------------------
+//
+// choose the history that the commit log graph will be drawn for
+//
+// ---------------------------------
+type GitCommitLogScopeOptionPopUpModel struct {
+	ScopeOptionList list.Model
+}
+
+// ---------------------------------
+//
+// choose the local and remote branches that the commit log graph will be drawn for
+//
+// ---------------------------------
+type GitCommitLogScopeBranchesPopUpModel struct {
+	CheckedBranches map[string]bool // keyed by the full ref name, so a local and a remote branch of the same name are told apart
+	BranchList      list.Model
+}
+
+// ---------------------------------
+//
+// for scope selection option
+//
+// ---------------------------------
+type (
+	GitCommitLogScopeOptionDelegate struct{}
+	GitCommitLogScopeOptionItem     struct {
+		Name string
+		Info string
+		Mode string
+	}
+)
+
+func (i GitCommitLogScopeOptionItem) FilterValue() string {
+	return i.Name
+}
+
+// ---------------------------------
+//
+// for the branches of the selected branches scope
+//
+// ---------------------------------
+type (
+	GitCommitLogScopeBranchDelegate struct {
+		CheckedBranches map[string]bool
+	}
+	GitCommitLogScopeBranchItem struct {
+		Branch git.CommitRef
+	}
+)
+
+func (i GitCommitLogScopeBranchItem) FilterValue() string {
+	return i.Branch.Name
+}
+
+// for scope selection
+func (d GitCommitLogScopeOptionDelegate) Height() int                             { return 1 }
+func (d GitCommitLogScopeOptionDelegate) Spacing() int                            { return 0 }
+func (d GitCommitLogScopeOptionDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+func (d GitCommitLogScopeOptionDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	i, ok := listItem.(GitCommitLogScopeOptionItem)
+	if !ok {
+		return
+	}
+
+	nameStr := fmt.Sprintf("   %s", i.Name)
+	infoStr := fmt.Sprintf("    %s", i.Info)
+
+	componentWidth := m.Width() - constant.ListItemOrTitleWidthPad - 2
+
+	nameStr = utils.TruncateString(nameStr, componentWidth)
+	infoStr = utils.TruncateString(infoStr, componentWidth)
+
+	nameRendered := style.ItemStyle.Render(nameStr)
+	infoRendered := style.ItemStyle.Faint(true).Render(infoStr)
+	fullStr := nameRendered + "\n" + "  " + infoRendered
+
+	var fn func(...string) string
+	if index == m.Index() {
+		fn = func(s ...string) string {
+			return style.SelectedItemStyle.Render("❯ " + strings.Join(s, " "))
+		}
+	} else {
+		fn = func(s ...string) string {
+			return style.ItemStyle.Render("  " + strings.Join(s, " "))
+		}
+	}
+
+	fmt.Fprint(w, fn(fullStr))
+}
+
+// for the branches selection
+func (d GitCommitLogScopeBranchDelegate) Height() int                             { return 1 }
+func (d GitCommitLogScopeBranchDelegate) Spacing() int                            { return 0 }
+func (d GitCommitLogScopeBranchDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+func (d GitCommitLogScopeBranchDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	i, ok := listItem.(GitCommitLogScopeBranchItem)
+	if !ok {
+		return
+	}
+
+	isChecked := d.CheckedBranches[i.Branch.FullName()]
+	checkbox := "[ ]"
+	if isChecked {
+		checkbox = "[x]"
+	}
+	componentWidth := m.Width() - constant.ListItemOrTitleWidthPad - 2
+	branchStr := utils.TruncateString(fmt.Sprintf("%s %s", checkbox, i.Branch.Name), componentWidth)
+
+	if isChecked {
+		branchStr = style.MarkedItemStyle.Render(branchStr)
+	}
+	if index == m.Index() {
+		fmt.Fprint(w, style.SelectedItemStyle.Render("❯ ")+branchStr)
+	} else {
+		fmt.Fprint(w, style.ItemStyle.Render("  ")+branchStr)
+	}
+}
@@ -0,0 +1,35 @@
+package logscope
+
+import (
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/tui/types"
+)
+
+// check or uncheck the selected branch
+func ToggleGitCommitLogScopeBranch(m *types.GittiModel) {
+	popUp, ok := m.PopUpModel.(*GitCommitLogScopeBranchesPopUpModel)
+	if !ok {
+		return
+	}
+	selectedItem, ok := popUp.BranchList.SelectedItem().(GitCommitLogScopeBranchItem)
+	if !ok {
+		return
+	}
+	fullName := selectedItem.Branch.FullName()
+	if popUp.CheckedBranches[fullName] {
+		delete(popUp.CheckedBranches, fullName)
+	} else {
+		popUp.CheckedBranches[fullName] = true
+	}
+}
+
+// the checked branches in the order of the list
+func CheckedGitCommitLogScopeBranches(popUp *GitCommitLogScopeBranchesPopUpModel) []git.CommitRef {
+	branches := []git.CommitRef{}
+	for _, item := range popUp.BranchList.Items() {
+		if branchItem, ok := item.(GitCommitLogScopeBranchItem); ok && popUp.CheckedBranches[branchItem.Branch.FullName()] {
+			branches = append(branches, branchItem.Branch)
+		}
+	}
+	return branches
+}
@@ -0,0 +1,110 @@
+package operation
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/list"
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/viewport"
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+	"github.com/gohyuhan/gitti/tui/utils"
+)
+
+// for the continue, skip or abort option list of the operation that the repo is in the middle of
+func InitGitRepoOperationOptionPopUpModel(m *types.GittiModel, operation string) {
+	items := []list.Item{}
+	for _, action := range git.RepoOperationActions(operation) {
+		items = append(items, GitRepoOperationOptionItem{
+			Name:   RepoOperationActionName(action),
+			Info:   "git " + strings.Join(git.RepoOperationActionGitArgs(operation, action), " "),
+			Action: action,
+		})
+	}
+
+	width := (min(constant.MaxGitRepoOperationOptionPopUpWidth, int(float64(m.Width)*0.8)) - 4)
+	rOOL := list.New(items, GitRepoOperationOptionDelegate{}, width, constant.PopUpGitRepoOperationOptionPopUpHeight)
+	rOOL.SetShowPagination(false)
+	rOOL.SetShowStatusBar(false)
+	rOOL.SetFilteringEnabled(false)
+	rOOL.SetShowTitle(false)
+
+	// Custom Help Model for Count Display
+	rOOL.SetShowHelp(true)
+	rOOL.KeyMap = list.KeyMap{}
+	rOOL.Styles.HelpStyle = style.NewStyle.MarginTop(0).MarginBottom(0).PaddingTop(0).PaddingBottom(0)
+	rOOL.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &rOOL, constant.MaxGitRepoOperationOptionPopUpWidth)
+
+	popUpModel := &GitRepoOperationOptionPopUpModel{
+		Operation:               operation,
+		RepoOperationOptionList: rOOL,
+	}
+
+	m.PopUpModel = popUpModel
+}
+
+func InitGitRepoOperationOutputPopUpModel(m *types.GittiModel, operation string, action string) {
+	// for git repo operation output viewport
+	vp := viewport.New()
+	vp.SoftWrap = true
+	vp.MouseWheelEnabled = true
+	vp.MouseWheelDelta = 1
+	vp.SetHeight(constant.PopUpGitRepoOperationOutputViewportHeight)
+	vp.SetWidth(min(constant.MaxGitRepoOperationOutputPopUpWidth, int(float64(m.Width)*0.8)) - 4)
+
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	s.Style = style.SpinnerStyle
+
+	popUpModel := &GitRepoOperationOutputPopUpModel{
+		Operation:                      operation,
+		Action:                         action,
+		GitRepoOperationOutputViewport: vp,
+		Spinner:                        s,
+	}
+	popUpModel.IsProcessing.Store(false)
+	popUpModel.HasError.Store(false)
+	popUpModel.ProcessSuccess.Store(false)
+	m.PopUpModel = popUpModel
+}
+
+// the name of the operation, eg, MERGING or REBASING 2/5 when the progress is known
+func RepoOperationStateName(state git.RepoOperationState) string {
+	var name string
+	switch state.Operation {
+	case git.REPOOPERATIONMERGE:
+		name = i18n.LANGUAGEMAPPING.RepoOperationMerging
+	case git.REPOOPERATIONREBASE:
+		name = i18n.LANGUAGEMAPPING.RepoOperationRebasing
+	case git.REPOOPERATIONAM:
+		name = i18n.LANGUAGEMAPPING.RepoOperationApplyingMailbox
+	case git.REPOOPERATIONCHERRYPICK:
+		name = i18n.LANGUAGEMAPPING.RepoOperationCherryPicking
+	case git.REPOOPERATIONREVERT:
+		name = i18n.LANGUAGEMAPPING.RepoOperationReverting
+	case git.REPOOPERATIONBISECT:
+		name = i18n.LANGUAGEMAPPING.RepoOperationBisecting
+	default:
+		return ""
+	}
+	if state.TotalSteps > 0 {
+		name = fmt.Sprintf("%s %d/%d", name, state.CurrentStep, state.TotalSteps)
+	}
+	return name
+}
+
+func RepoOperationActionName(action string) string {
+	switch action {
+	case git.REPOOPERATIONCONTINUE:
+		return i18n.LANGUAGEMAPPING.GitRepoOperationContinue
+	case git.REPOOPERATIONSKIP:
+		return i18n.LANGUAGEMAPPING.GitRepoOperationSkip
+	case git.REPOOPERATIONABORT:
+		return i18n.LANGUAGEMAPPING.GitRepoOperationAbort
+	}
+	return ""
+}
@@ -0,0 +1,77 @@
+package operation
+
+import (
+	"fmt"
+
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+
+	"charm.land/lipgloss/v2"
+)
+
+// ------------------------------------
+//
+//	For continuing, skipping or aborting the operation that the repo is in the middle of
+//
+// ------------------------------------
+// choose the action
+func RenderGitRepoOperationOptionPopUp(m *types.GittiModel) string {
+	popUp, ok := m.PopUpModel.(*GitRepoOperationOptionPopUpModel)
+	if ok {
+		popUpWidth := min(constant.MaxGitRepoOperationOptionPopUpWidth, int(float64(m.Width)*0.8))
+		title := style.TitleStyle.Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitRepoOperationOptionTitle, RepoOperationStateName(m.RepoOperationState)))
+		popUp.RepoOperationOptionList.SetWidth(popUpWidth - 4)
+		content := lipgloss.JoinVertical(
+			lipgloss.Left,
+			title,
+			popUp.RepoOperationOptionList.View(),
+		)
+		return style.PopUpBorderStyle.Width(popUpWidth).Render(content)
+	}
+	return ""
+}
+
+// for the output of the action
+func RenderGitRepoOperationOutputPopUp(m *types.GittiModel) string {
+	popUp, ok := m.PopUpModel.(*GitRepoOperationOutputPopUpModel)
+	if ok {
+		popUpWidth := min(constant.MaxGitRepoOperationOutputPopUpWidth, int(float64(m.Width)*0.8))
+		title := style.TitleStyle.Render(RepoOperationActionName(popUp.Action))
+		logViewPortStyle := style.PanelBorderStyle.
+			Width(popUpWidth - 2).
+			Height(constant.PopUpGitRepoOperationOutputViewportHeight + 2)
+		if popUp.HasError.Load() {
+			logViewPortStyle = style.PanelBorderStyle.
+				BorderForeground(style.ColorError)
+		} else if popUp.ProcessSuccess.Load() {
+			logViewPortStyle = style.PanelBorderStyle.
+				BorderForeground(style.ColorGreenSoft)
+		}
+		popUp.GitRepoOperationOutputViewport.SetWidth(popUpWidth - 4)
+		popUp.GitRepoOperationOutputViewport.SetYOffset(popUp.GitRepoOperationOutputViewport.YOffset())
+		logViewPort := logViewPortStyle.Render(popUp.GitRepoOperationOutputViewport.View())
+
+		var content string
+		// Show spinner above viewport when processing
+		if popUp.IsProcessing.Load() {
+			processingText := style.SpinnerStyle.Render(popUp.Spinner.View() + " " + i18n.LANGUAGEMAPPING.GitRepoOperationProcessing)
+			content = lipgloss.JoinVertical(
+				lipgloss.Left,
+				title,
+				"",
+				processingText,
+				logViewPort,
+			)
+		} else {
+			content = lipgloss.JoinVertical(
+				lipgloss.Left,
+				title,
+				logViewPort,
+			)
+		}
+		return style.PopUpBorderStyle.Width(popUpWidth).Render(content)
+	}
+	return ""
+}
@@ -0,0 +1,95 @@
+package operation
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync/atomic"
+
+	"charm.land/bubbles/v2/list"
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/utils"
+)
+
+// ---------------------------------
+//
+// choose to continue, skip or abort the operation that the repo is in the middle of
+//
+// ---------------------------------
+type GitRepoOperationOptionPopUpModel struct {
+	Operation               string
+	RepoOperationOptionList list.Model
+}
+
+// ---------------------------------
+//
+// # A pop up to show the output of continuing, skipping or aborting the operation
+//
+// ---------------------------------
+type GitRepoOperationOutputPopUpModel struct {
+	Operation                      string
+	Action                         string
+	GitRepoOperationOutputViewport viewport.Model // to log out the output from git operation
+	Spinner                        spinner.Model  // spinner for showing processing state
+	IsProcessing                   atomic.Bool    // indicator to prevent multiple thread spawning reacting to the key binding trigger
+	HasError                       atomic.Bool    // indicate if the git operation exitcode is not 0 (meaning have error)
+	ProcessSuccess                 atomic.Bool    // has the process sucessfuly executed
+}
+
+// ---------------------------------
+//
+// for repo operation action selection option
+//
+// ---------------------------------
+type (
+	GitRepoOperationOptionDelegate struct{}
+	GitRepoOperationOptionItem     struct {
+		Name   string
+		Info   string
+		Action string
+	}
+)
+
+func (i GitRepoOperationOptionItem) FilterValue() string {
+	return i.Name
+}
+
+// for repo operation action selection
+func (d GitRepoOperationOptionDelegate) Height() int                             { return 1 }
+func (d GitRepoOperationOptionDelegate) Spacing() int                            { return 0 }
+func (d GitRepoOperationOptionDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+func (d GitRepoOperationOptionDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	i, ok := listItem.(GitRepoOperationOptionItem)
+	if !ok {
+		return
+	}
+
+	nameStr := fmt.Sprintf("   %s", i.Name)
+	infoStr := fmt.Sprintf("    %s", i.Info)
+
+	componentWidth := m.Width() - constant.ListItemOrTitleWidthPad - 2
+
+	nameStr = utils.TruncateString(nameStr, componentWidth)
+	infoStr = utils.TruncateString(infoStr, componentWidth)
+
+	nameRendered := style.ItemStyle.Render(nameStr)
+	infoRendered := style.ItemStyle.Faint(true).Render(infoStr)
+	fullStr := nameRendered + "\n" + "  " + infoRendered
+
+	var fn func(...string) string
+	if index == m.Index() {
+		fn = func(s ...string) string {
+			return style.SelectedItemStyle.Render("❯ " + strings.Join(s, " "))
+		}
+	} else {
+		fn = func(s ...string) string {
+			return style.ItemStyle.Render("  " + strings.Join(s, " "))
+		}
+	}
+
+	fmt.Fprint(w, fn(fullStr))
+}
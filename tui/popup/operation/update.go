@@ -0,0 +1,23 @@
+package operation
+
+import (
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+)
+
+func UpdatePopUpGitRepoOperationOutputViewport(m *types.GittiModel) {
+	popUp, ok := m.PopUpModel.(*GitRepoOperationOutputPopUpModel)
+	if ok {
+		popUp.GitRepoOperationOutputViewport.SetWidth(min(constant.MaxGitRepoOperationOutputPopUpWidth, int(float64(m.Width)*0.8)) - 4)
+		popUp.GitRepoOperationOutputViewport.SetYOffset(popUp.GitRepoOperationOutputViewport.YOffset())
+		logs := m.GitOperations.GitRepoOperation.GetGitRepoOperationOutput()
+		var gitRepoOperationLog string
+		for _, line := range logs {
+			logLine := style.NewStyle.Render(line)
+			gitRepoOperationLog += logLine + "\n"
+		}
+		popUp.GitRepoOperationOutputViewport.SetContent(gitRepoOperationLog)
+		popUp.GitRepoOperationOutputViewport.PageDown()
+	}
+}
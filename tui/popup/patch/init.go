@@ -0,0 +1,14 @@
+package patch
+
+import (
+	"github.com/gohyuhan/gitti/tui/types"
+)
+
+// for apply patch error popup
+func InitGitApplyPatchErrorPopUpModel(m *types.GittiModel, err error) {
+	popUpModel := &GitApplyPatchErrorPopUpModel{
+		Err: err,
+	}
+
+	m.PopUpModel = popUpModel
+}
@@ -0,0 +1,33 @@
+package patch
+
+import (
+	"fmt"
+
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+
+	"charm.land/lipgloss/v2"
+)
+
+// ------------------------------------
+//
+//	For the error of applying the hunk/line changes
+//
+// ------------------------------------
+func RenderGitApplyPatchErrorPopUp(m *types.GittiModel) string {
+	popUp, ok := m.PopUpModel.(*GitApplyPatchErrorPopUpModel)
+	if ok {
+		popUpWidth := min(constant.MaxGitApplyPatchErrorPopUpWidth, int(float64(m.Width)*0.8))
+		title := style.TitleStyle.Render(i18n.LANGUAGEMAPPING.GitApplyPatchErrorTitle)
+		result := style.ErrorStyle.Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitApplyPatchFailed, popUp.Err.Error()))
+		content := lipgloss.JoinVertical(
+			lipgloss.Left,
+			title,
+			style.NewStyle.Width(popUpWidth-4).Render(result),
+		)
+		return style.PopUpBorderStyle.Width(popUpWidth).Render(content)
+	}
+	return ""
+}
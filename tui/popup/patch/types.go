@@ -0,0 +1,10 @@
+package patch
+
+// ---------------------------------
+//
+// for reporting the hunk/line changes that could not be staged, unstaged or discarded
+//
+// ---------------------------------
+type GitApplyPatchErrorPopUpModel struct {
+	Err error // the output of git apply
+}
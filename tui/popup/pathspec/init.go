@@ -0,0 +1,83 @@
+package pathspec
+
+import (
+	"fmt"
+
+	"charm.land/bubbles/v2/list"
+	"charm.land/bubbles/v2/textinput"
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+	"github.com/gohyuhan/gitti/tui/utils"
+)
+
+// for pathspec prompt popup
+func InitGitPathspecPopUpModel(m *types.GittiModel) {
+	pathspecTextInput := textinput.New()
+	pathspecTextInput.Placeholder = i18n.LANGUAGEMAPPING.GitPathspecPlaceholder
+	pathspecTextInput.Focus()
+	pathspecTextInput.SetVirtualCursor(true)
+	pathspecTextInput.SetWidth(min(constant.MaxGitPathspecPopUpWidth, int(float64(m.Width)*0.8)) - 4)
+
+	popUpModel := &GitPathspecPopUpModel{
+		PathspecInput:  pathspecTextInput,
+		MatchedFiles:   []git.FileStatus{},
+		IsValidPattern: false,
+	}
+
+	m.PopUpModel = popUpModel
+}
+
+// for the action option list of the files matched by the pathspec
+func InitGitPathspecActionOptionPopUpModel(m *types.GittiModel, pattern string, matchedFiles []git.FileStatus) {
+	pathspecActionOption := []GitPathspecActionOptionItem{
+		{
+			Name:   i18n.LANGUAGEMAPPING.GitPathspecActionStage,
+			Info:   fmt.Sprintf(i18n.LANGUAGEMAPPING.GitPathspecActionStageInfo, len(matchedFiles)),
+			Action: constant.PATHSPECSTAGEFILES,
+		},
+		{
+			Name:   i18n.LANGUAGEMAPPING.GitPathspecActionUnstage,
+			Info:   fmt.Sprintf(i18n.LANGUAGEMAPPING.GitPathspecActionUnstageInfo, len(matchedFiles)),
+			Action: constant.PATHSPECUNSTAGEFILES,
+		},
+		{
+			Name:   i18n.LANGUAGEMAPPING.GitPathspecActionDiscard,
+			Info:   fmt.Sprintf(i18n.LANGUAGEMAPPING.GitPathspecActionDiscardInfo, len(matchedFiles)),
+			Action: constant.BATCHDISCARDFILES,
+		},
+		{
+			Name:   i18n.LANGUAGEMAPPING.GitPathspecActionStash,
+			Info:   fmt.Sprintf(i18n.LANGUAGEMAPPING.GitPathspecActionStashInfo, len(matchedFiles)),
+			Action: constant.BATCHSTASHFILES,
+		},
+	}
+
+	items := make([]list.Item, 0, len(pathspecActionOption))
+	for _, option := range pathspecActionOption {
+		items = append(items, option)
+	}
+
+	width := (min(constant.MaxGitPathspecActionOptionPopUpWidth, int(float64(m.Width)*0.8)) - 4)
+	pAOL := list.New(items, GitPathspecActionOptionDelegate{}, width, constant.PopUpGitPathspecActionOptionPopUpHeight)
+	pAOL.SetShowPagination(false)
+	pAOL.SetShowStatusBar(false)
+	pAOL.SetFilteringEnabled(false)
+	pAOL.SetShowTitle(false)
+
+	// Custom Help Model for Count Display
+	pAOL.SetShowHelp(true)
+	pAOL.KeyMap = list.KeyMap{}
+	pAOL.Styles.HelpStyle = style.NewStyle.MarginTop(0).MarginBottom(0).PaddingTop(0).PaddingBottom(0)
+	pAOL.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &pAOL, constant.MaxGitPathspecActionOptionPopUpWidth)
+
+	popUpModel := &GitPathspecActionOptionPopUpModel{
+		Pattern:                  pattern,
+		MatchedFiles:             matchedFiles,
+		PathspecActionOptionList: pAOL,
+	}
+
+	m.PopUpModel = popUpModel
+}
@@ -0,0 +1,73 @@
+package pathspec
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+	"github.com/gohyuhan/gitti/tui/utils"
+
+	"charm.land/lipgloss/v2"
+)
+
+// ------------------------------------
+//
+//	For the pathspec prompt with the preview of the matched files
+//
+// ------------------------------------
+func RenderGitPathspecPopUp(m *types.GittiModel) string {
+	popUp, ok := m.PopUpModel.(*GitPathspecPopUpModel)
+	if ok {
+		popUpWidth := min(constant.MaxGitPathspecPopUpWidth, int(float64(m.Width)*0.8))
+		title := style.TitleStyle.Render(i18n.LANGUAGEMAPPING.GitPathspecTitle)
+		popUp.PathspecInput.SetWidth(popUpWidth - 4)
+
+		var preview strings.Builder
+		switch {
+		case strings.TrimSpace(popUp.PathspecInput.Value()) == "":
+			preview.WriteString(style.NewStyle.Faint(true).Render(i18n.LANGUAGEMAPPING.GitPathspecHint))
+		case !popUp.IsValidPattern:
+			preview.WriteString(style.ErrorStyle.Render(i18n.LANGUAGEMAPPING.GitPathspecInvalid))
+		default:
+			preview.WriteString(style.NewStyle.Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitPathspecMatchedCount, len(popUp.MatchedFiles))) + "\n")
+			for index, file := range popUp.MatchedFiles {
+				if index >= constant.PopUpGitPathspecPreviewMaxHeight {
+					preview.WriteString(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitBatchOperationMoreItems, len(popUp.MatchedFiles)-index))
+					break
+				}
+				fileState := style.StagedFileStyle.Render(file.IndexState) + style.UnstagedFileStyle.Render(file.WorkTree)
+				preview.WriteString(" " + fileState + " " + style.NewStyle.Render(utils.TruncateString(file.DisplayPathname(), popUpWidth-10)) + "\n")
+			}
+		}
+
+		content := lipgloss.JoinVertical(
+			lipgloss.Left,
+			title,
+			popUp.PathspecInput.View(),
+			"",
+			strings.TrimRight(preview.String(), "\n"),
+		)
+		return style.PopUpBorderStyle.Width(popUpWidth).Render(content)
+	}
+	return ""
+}
+
+// for choosing what to do with the matched files
+func RenderGitPathspecActionOptionPopUp(m *types.GittiModel) string {
+	popUp, ok := m.PopUpModel.(*GitPathspecActionOptionPopUpModel)
+	if ok {
+		popUpWidth := min(constant.MaxGitPathspecActionOptionPopUpWidth, int(float64(m.Width)*0.8))
+		title := style.TitleStyle.Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitPathspecActionOptionTitle, popUp.Pattern))
+		popUp.PathspecActionOptionList.SetWidth(popUpWidth - 4)
+		content := lipgloss.JoinVertical(
+			lipgloss.Left,
+			title,
+			popUp.PathspecActionOptionList.View(),
+		)
+		return style.PopUpBorderStyle.Width(popUpWidth).Render(content)
+	}
+	return ""
+}
@@ -0,0 +1,91 @@
+package pathspec
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"charm.land/bubbles/v2/list"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/utils"
+)
+
+// ---------------------------------
+//
+// # A pop up to prompt for a pathspec or glob, the matching modified files are previewed as it is typed
+//
+// ---------------------------------
+type GitPathspecPopUpModel struct {
+	PathspecInput  textinput.Model
+	MatchedFiles   []git.FileStatus
+	IsValidPattern bool
+}
+
+// ---------------------------------
+//
+// for choosing what to do with the files matched by the pathspec
+//
+// ---------------------------------
+type GitPathspecActionOptionPopUpModel struct {
+	Pattern                  string
+	MatchedFiles             []git.FileStatus
+	PathspecActionOptionList list.Model
+}
+
+// ---------------------------------
+//
+// for pathspec action selection option
+//
+// ---------------------------------
+type (
+	GitPathspecActionOptionDelegate struct{}
+	GitPathspecActionOptionItem     struct {
+		Name   string
+		Info   string
+		Action string
+	}
+)
+
+func (i GitPathspecActionOptionItem) FilterValue() string {
+	return i.Name
+}
+
+// for pathspec action selection
+func (d GitPathspecActionOptionDelegate) Height() int                             { return 1 }
+func (d GitPathspecActionOptionDelegate) Spacing() int                            { return 0 }
+func (d GitPathspecActionOptionDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+func (d GitPathspecActionOptionDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	i, ok := listItem.(GitPathspecActionOptionItem)
+	if !ok {
+		return
+	}
+
+	nameStr := fmt.Sprintf("   %s", i.Name)
+	infoStr := fmt.Sprintf("    %s", i.Info)
+
+	componentWidth := m.Width() - constant.ListItemOrTitleWidthPad - 2
+
+	nameStr = utils.TruncateString(nameStr, componentWidth)
+	infoStr = utils.TruncateString(infoStr, componentWidth)
+
+	nameRendered := style.ItemStyle.Render(nameStr)
+	infoRendered := style.ItemStyle.Faint(true).Render(infoStr)
+	fullStr := nameRendered + "\n" + "  " + infoRendered
+
+	var fn func(...string) string
+	if index == m.Index() {
+		fn = func(s ...string) string {
+			return style.SelectedItemStyle.Render("❯ " + strings.Join(s, " "))
+		}
+	} else {
+		fn = func(s ...string) string {
+			return style.ItemStyle.Render("  " + strings.Join(s, " "))
+		}
+	}
+
+	fmt.Fprint(w, fn(fullStr))
+}
@@ -0,0 +1,13 @@
+package pathspec
+
+import (
+	"github.com/gohyuhan/gitti/tui/types"
+)
+
+// match the modified files against the pattern that was typed
+func UpdateGitPathspecPreview(m *types.GittiModel) {
+	popUp, ok := m.PopUpModel.(*GitPathspecPopUpModel)
+	if ok {
+		popUp.MatchedFiles, popUp.IsValidPattern = m.GitOperations.GitFiles.FilesMatchingPathspec(popUp.PathspecInput.Value())
+	}
+}
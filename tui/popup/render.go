@@ -2,14 +2,24 @@ package popup
 
 import (
 	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/popup/batch"
 	"github.com/gohyuhan/gitti/tui/popup/branch"
+	"github.com/gohyuhan/gitti/tui/popup/clean"
 	"github.com/gohyuhan/gitti/tui/popup/commit"
+	"github.com/gohyuhan/gitti/tui/popup/commitparent"
 	"github.com/gohyuhan/gitti/tui/popup/discard"
+	"github.com/gohyuhan/gitti/tui/popup/ignore"
 	"github.com/gohyuhan/gitti/tui/popup/keybinding"
+	"github.com/gohyuhan/gitti/tui/popup/logfilter"
+	"github.com/gohyuhan/gitti/tui/popup/logscope"
+	"github.com/gohyuhan/gitti/tui/popup/operation"
+	"github.com/gohyuhan/gitti/tui/popup/patch"
+	"github.com/gohyuhan/gitti/tui/popup/pathspec"
 	"github.com/gohyuhan/gitti/tui/popup/pull"
 	"github.com/gohyuhan/gitti/tui/popup/push"
 	"github.com/gohyuhan/gitti/tui/popup/remote"
 	"github.com/gohyuhan/gitti/tui/popup/resolve"
+	"github.com/gohyuhan/gitti/tui/popup/restore"
 	"github.com/gohyuhan/gitti/tui/popup/stash"
 	"github.com/gohyuhan/gitti/tui/types"
 )
@@ -62,6 +72,42 @@ func RenderPopUpComponent(m *types.GittiModel) string {
 		popUp = stash.RenderGitStashConfirmPromptPopUp(m)
 	case constant.GitResolveConflictOptionPopUp:
 		popUp = resolve.RenderGitResolveConflictOptionPopUp(m)
+	case constant.GitConflictEditorPopUp:
+		popUp = resolve.RenderGitConflictEditorPopUp(m)
+	case constant.GitMergeToolResultPopUp:
+		popUp = resolve.RenderGitMergeToolResultPopUp(m)
+	case constant.GitRepoOperationOptionPopUp:
+		popUp = operation.RenderGitRepoOperationOptionPopUp(m)
+	case constant.GitPathspecPopUp:
+		popUp = pathspec.RenderGitPathspecPopUp(m)
+	case constant.GitPathspecActionOptionPopUp:
+		popUp = pathspec.RenderGitPathspecActionOptionPopUp(m)
+	case constant.GitIgnoreRuleOptionPopUp:
+		popUp = ignore.RenderGitIgnoreRuleOptionPopUp(m)
+	case constant.GitCleanPopUp:
+		popUp = clean.RenderGitCleanPopUp(m)
+	case constant.GitIgnoreTargetOptionPopUp:
+		popUp = ignore.RenderGitIgnoreTargetOptionPopUp(m)
+	case constant.GitRestoreSourcePopUp:
+		popUp = restore.RenderGitRestoreSourcePopUp(m)
+	case constant.GitRestoreFilePopUp:
+		popUp = restore.RenderGitRestoreFilePopUp(m)
+	case constant.GitRestoreTargetOptionPopUp:
+		popUp = restore.RenderGitRestoreTargetOptionPopUp(m)
+	case constant.GitRestoreConfirmPromptPopUp:
+		popUp = restore.RenderGitRestoreConfirmPromptPopUp(m)
+	case constant.GitCommitLogFilterPopUp:
+		popUp = logfilter.RenderGitCommitLogFilterPopUp(m)
+	case constant.GitCommitLogScopeOptionPopUp:
+		popUp = logscope.RenderGitCommitLogScopeOptionPopUp(m)
+	case constant.GitCommitLogScopeBranchesPopUp:
+		popUp = logscope.RenderGitCommitLogScopeBranchesPopUp(m)
+	case constant.GitCommitParentOptionPopUp:
+		popUp = commitparent.RenderGitCommitParentOptionPopUp(m)
+	case constant.GitApplyPatchErrorPopUp:
+		popUp = patch.RenderGitApplyPatchErrorPopUp(m)
+	case constant.GitRepoOperationOutputPopUp:
+		popUp = operation.RenderGitRepoOperationOutputPopUp(m)
 	case constant.GitDeleteBranchConfirmPromptPopUp:
 		popUp = branch.RenderGitDeleteBranchConfirmPromptPopUp(m)
 	case constant.GitDeleteBranchOutputPopUp:
@@ -70,6 +116,10 @@ func RenderPopUpComponent(m *types.GittiModel) string {
 		popUp = branch.RenderCreateBranchBasedOnRemotePopUp(m)
 	case constant.CreateBranchBasedOnRemoteOutputPopUp:
 		popUp = branch.RenderCreateBranchBasedOnRemoteOutputPopUp(m)
+	case constant.GitBatchOperationConfirmPromptPopUp:
+		popUp = batch.RenderGitBatchOperationConfirmPromptPopUp(m)
+	case constant.GitBatchOperationOutputPopUp:
+		popUp = batch.RenderGitBatchOperationOutputPopUp(m)
 	}
 	return popUp
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"charm.land/bubbles/v2/list"
+	"charm.land/bubbles/v2/viewport"
 	"github.com/gohyuhan/gitti/api/git"
 	"github.com/gohyuhan/gitti/i18n"
 	"github.com/gohyuhan/gitti/tui/constant"
@@ -15,6 +16,16 @@ import (
 // for resolve conflict option list popup
 func InitGitResolveConflictOptionPopUpModel(m *types.GittiModel, filePathName string) {
 	resolveConflictOption := []GitResolveConflictOptionItem{
+		{
+			Name:        i18n.LANGUAGEMAPPING.GitResolveConflictEditor,
+			Info:        fmt.Sprintf(i18n.LANGUAGEMAPPING.GitResolveConflictEditorInfo, filePathName),
+			ResolveType: constant.CONFLICTEDITOR,
+		},
+		{
+			Name:        i18n.LANGUAGEMAPPING.GitResolveConflictMergeTool,
+			Info:        fmt.Sprintf("git mergetool -- %s", filePathName),
+			ResolveType: constant.CONFLICTMERGETOOL,
+		},
 		{
 			Name:        i18n.LANGUAGEMAPPING.GitResolveConflictReset,
 			Info:        fmt.Sprintf(i18n.LANGUAGEMAPPING.GitResolveConflictResetInfo, filePathName),
@@ -32,6 +43,11 @@ func InitGitResolveConflictOptionPopUpModel(m *types.GittiModel, filePathName st
 		},
 	}
 
+	// the editor is only offered when there is a conflict block to be resolved within it (eg, not for modify/delete or binary conflict)
+	if conflictFile, ok := m.GitOperations.GitFiles.GetConflictFile(filePathName); !ok || len(conflictFile.Blocks()) == 0 {
+		resolveConflictOption = resolveConflictOption[1:]
+	}
+
 	items := make([]list.Item, 0, len(resolveConflictOption))
 	for _, resolveConflictOption := range resolveConflictOption {
 		items = append(items, GitResolveConflictOptionItem(resolveConflictOption))
@@ -57,3 +73,34 @@ func InitGitResolveConflictOptionPopUpModel(m *types.GittiModel, filePathName st
 
 	m.PopUpModel = popUpModel
 }
+
+// for conflict editor popup
+func InitGitConflictEditorPopUpModel(m *types.GittiModel, filePathName string, conflictFile git.ConflictFile) {
+	vp := viewport.New()
+	vp.SoftWrap = false
+	vp.MouseWheelEnabled = true
+	vp.MouseWheelDelta = 1
+	vp.SetHeight(max(min(constant.PopUpGitConflictEditorViewportHeight, m.Height-12), 1))
+	vp.SetWidth(min(constant.MaxGitConflictEditorPopUpWidth, int(float64(m.Width)*0.8)) - 4)
+
+	popUpModel := &GitConflictEditorPopUpModel{
+		FilePathName:           filePathName,
+		ConflictFile:           conflictFile,
+		CurrentBlockIndex:      0,
+		ConflictEditorViewport: vp,
+	}
+
+	m.PopUpModel = popUpModel
+	UpdateGitConflictEditorPreview(m)
+}
+
+// for merge tool result popup
+func InitGitMergeToolResultPopUpModel(m *types.GittiModel, filePathName string, isResolved bool, err error) {
+	popUpModel := &GitMergeToolResultPopUpModel{
+		FilePathName: filePathName,
+		IsResolved:   isResolved,
+		Err:          err,
+	}
+
+	m.PopUpModel = popUpModel
+}
@@ -1,6 +1,9 @@
 package resolve
 
 import (
+	"fmt"
+
+	"github.com/gohyuhan/gitti/api/git"
 	"github.com/gohyuhan/gitti/i18n"
 	"github.com/gohyuhan/gitti/tui/constant"
 	"github.com/gohyuhan/gitti/tui/style"
@@ -30,3 +33,65 @@ func RenderGitResolveConflictOptionPopUp(m *types.GittiModel) string {
 	}
 	return ""
 }
+
+// ------------------------------------
+//
+//	For the conflict editor
+//
+// ------------------------------------
+func RenderGitConflictEditorPopUp(m *types.GittiModel) string {
+	popUp, ok := m.PopUpModel.(*GitConflictEditorPopUpModel)
+	if ok {
+		popUpWidth := min(constant.MaxGitConflictEditorPopUpWidth, int(float64(m.Width)*0.8))
+		blocks := popUp.ConflictFile.Blocks()
+		resolvedCount := 0
+		for _, block := range blocks {
+			if block.Resolution != git.CONFLICTBLOCKUNRESOLVED {
+				resolvedCount++
+			}
+		}
+		title := style.TitleStyle.Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitConflictEditorTitle, popUp.FilePathName, resolvedCount, len(blocks)))
+
+		popUp.ConflictEditorViewport.SetWidth(popUpWidth - 4)
+		contents := []string{
+			title,
+			style.PanelBorderStyle.Width(popUpWidth - 2).Render(popUp.ConflictEditorViewport.View()),
+		}
+		if !popUp.ConflictFile.IsResolved() {
+			contents = append(contents, style.NewStyle.Faint(true).Render(i18n.LANGUAGEMAPPING.GitConflictEditorNotStagedInfo))
+		}
+		content := lipgloss.JoinVertical(lipgloss.Left, contents...)
+		return style.PopUpBorderStyle.Width(popUpWidth).Render(content)
+	}
+	return ""
+}
+
+// ------------------------------------
+//
+//	For the result of the merge tool
+//
+// ------------------------------------
+func RenderGitMergeToolResultPopUp(m *types.GittiModel) string {
+	popUp, ok := m.PopUpModel.(*GitMergeToolResultPopUpModel)
+	if ok {
+		popUpWidth := min(constant.MaxGitMergeToolResultPopUpWidth, int(float64(m.Width)*0.8))
+		title := style.TitleStyle.Render(i18n.LANGUAGEMAPPING.GitMergeToolResultTitle)
+
+		var result string
+		switch {
+		case popUp.Err != nil:
+			result = style.ErrorStyle.Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitMergeToolFailed, popUp.Err.Error()))
+		case popUp.IsResolved:
+			result = style.NewStyle.Foreground(style.ColorGreenSoft).Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitMergeToolResolved, popUp.FilePathName))
+		default:
+			result = style.NewStyle.Foreground(style.ColorYellowWarm).Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitMergeToolUnresolved, popUp.FilePathName))
+		}
+		content := lipgloss.JoinVertical(
+			lipgloss.Left,
+			title,
+			style.NewStyle.Width(popUpWidth-4).Render(result),
+		)
+		return style.PopUpBorderStyle.Width(popUpWidth).Render(content)
+	}
+	return ""
+}
@@ -6,7 +6,9 @@ import (
 	"strings"
 
 	"charm.land/bubbles/v2/list"
+	"charm.land/bubbles/v2/viewport"
 	tea "charm.land/bubbletea/v2"
+	"github.com/gohyuhan/gitti/api/git"
 	"github.com/gohyuhan/gitti/tui/constant"
 	"github.com/gohyuhan/gitti/tui/style"
 	"github.com/gohyuhan/gitti/tui/utils"
@@ -22,6 +24,29 @@ type GitResolveConflictOptionPopUpModel struct {
 	ResolveConflictOptionList list.Model
 }
 
+// ---------------------------------
+//
+// for conflict editor pop up
+//
+// ---------------------------------
+type GitConflictEditorPopUpModel struct {
+	FilePathName           string
+	ConflictFile           git.ConflictFile
+	CurrentBlockIndex      int
+	ConflictEditorViewport viewport.Model // preview of the merged result
+}
+
+// ---------------------------------
+//
+// for reporting the result of the merge tool
+//
+// ---------------------------------
+type GitMergeToolResultPopUpModel struct {
+	FilePathName string
+	IsResolved   bool
+	Err          error // the merge tool failed to launch or exited with error
+}
+
 // ---------------------------------
 //
 // for resolve conflict option selection option
@@ -0,0 +1,164 @@
+package resolve
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+)
+
+// move the conflict editor to the previous or next conflict block
+func MoveGitConflictEditorBlock(m *types.GittiModel, step int) {
+	popUp, ok := m.PopUpModel.(*GitConflictEditorPopUpModel)
+	if !ok {
+		return
+	}
+	blockCount := len(popUp.ConflictFile.Blocks())
+	if blockCount < 1 {
+		return
+	}
+	popUp.CurrentBlockIndex = min(max(popUp.CurrentBlockIndex+step, 0), blockCount-1)
+	UpdateGitConflictEditorPreview(m)
+}
+
+// ------------------------------------
+//
+//	Choose how the current conflict block will be resolved
+//	* the editor will move on to the next block once the block was resolved
+//	* base can only be chosen when the block has a base section (diff3 or zdiff3 conflict style)
+//
+// ------------------------------------
+func ResolveGitConflictEditorBlock(m *types.GittiModel, resolution string) {
+	popUp, ok := m.PopUpModel.(*GitConflictEditorPopUpModel)
+	if !ok {
+		return
+	}
+	blocks := popUp.ConflictFile.Blocks()
+	if popUp.CurrentBlockIndex >= len(blocks) {
+		return
+	}
+	block := blocks[popUp.CurrentBlockIndex]
+	if resolution == git.CONFLICTBLOCKBASE && !block.HasBase {
+		return
+	}
+
+	block.Resolution = resolution
+	if resolution != git.CONFLICTBLOCKUNRESOLVED && popUp.CurrentBlockIndex < len(blocks)-1 {
+		popUp.CurrentBlockIndex++
+	}
+	UpdateGitConflictEditorPreview(m)
+}
+
+// ------------------------------------
+//
+//	Render the merged result into the preview viewport
+//	* an unresolved block is shown with its conflict markers, a resolved block with the lines it was resolved to
+//	* the preview will be scrolled so that the current block is visible
+//
+// ------------------------------------
+func UpdateGitConflictEditorPreview(m *types.GittiModel) {
+	popUp, ok := m.PopUpModel.(*GitConflictEditorPopUpModel)
+	if !ok {
+		return
+	}
+	popUp.ConflictEditorViewport.SetWidth(min(constant.MaxGitConflictEditorPopUpWidth, int(float64(m.Width)*0.8)) - 4)
+
+	blocks := popUp.ConflictFile.Blocks()
+	if len(blocks) < 1 {
+		popUp.ConflictEditorViewport.SetContent(style.NewStyle.Render(i18n.LANGUAGEMAPPING.GitConflictEditorNoConflictBlock))
+		return
+	}
+
+	var preview strings.Builder
+	lineCount := 0
+	currentBlockStart, currentBlockEnd := 0, 0
+	blockIndex := 0
+	writeLine := func(gutter string, line string) {
+		preview.WriteString(gutter + line + "\n")
+		lineCount++
+	}
+	for _, segment := range popUp.ConflictFile.Segments {
+		if segment.Block == nil {
+			for _, line := range segment.Lines {
+				writeLine("  ", style.NewStyle.Render(line))
+			}
+			continue
+		}
+
+		isCurrentBlock := blockIndex == popUp.CurrentBlockIndex
+		gutter := "  "
+		if isCurrentBlock {
+			gutter = style.DiffCursorStyle.Render("▌") + " "
+			currentBlockStart = lineCount
+		}
+		blockHeader := fmt.Sprintf("── %d/%d: %s ──", blockIndex+1, len(blocks), conflictBlockResolutionName(segment.Block.Resolution))
+		writeLine(gutter, style.ConflictMarkerStyle.Faint(!isCurrentBlock).Render(blockHeader))
+		for _, line := range renderConflictBlockLines(segment.Block) {
+			writeLine(gutter, line)
+		}
+		if isCurrentBlock {
+			currentBlockEnd = lineCount - 1
+		}
+		blockIndex++
+	}
+	popUp.ConflictEditorViewport.SetContent(preview.String())
+
+	vp := &popUp.ConflictEditorViewport
+	if currentBlockStart < vp.YOffset() || currentBlockEnd >= vp.YOffset()+vp.Height() {
+		vp.SetYOffset(max(currentBlockStart-2, 0))
+	}
+}
+
+// the lines of the block as they will be written, an unresolved block will be colored by its section
+func renderConflictBlockLines(block *git.ConflictBlock) []string {
+	if block.Resolution != git.CONFLICTBLOCKUNRESOLVED {
+		renderedLines := []string{}
+		for _, line := range block.ResolvedLines() {
+			renderedLines = append(renderedLines, style.NewStyle.Render(line))
+		}
+		return renderedLines
+	}
+
+	// the marker lines are taken from the raw lines so that their labels are shown as they are in the file
+	renderedLines := []string{style.ConflictMarkerStyle.Render(block.RawLines[0])}
+	markerIndex := 1
+	renderSection := func(lines []string, sectionStyle lipgloss.Style) {
+		for _, line := range lines {
+			renderedLines = append(renderedLines, sectionStyle.Render(line))
+		}
+		markerIndex += len(lines)
+	}
+	renderMarker := func() {
+		renderedLines = append(renderedLines, style.ConflictMarkerStyle.Render(block.RawLines[markerIndex]))
+		markerIndex++
+	}
+
+	renderSection(block.Ours, style.ConflictOursStyle)
+	if block.HasBase {
+		renderMarker()
+		renderSection(block.Base, style.ConflictBaseStyle)
+	}
+	renderMarker()
+	renderSection(block.Theirs, style.ConflictTheirsStyle)
+	renderMarker()
+	return renderedLines
+}
+
+func conflictBlockResolutionName(resolution string) string {
+	switch resolution {
+	case git.CONFLICTBLOCKOURS:
+		return i18n.LANGUAGEMAPPING.GitConflictEditorResolutionOurs
+	case git.CONFLICTBLOCKTHEIRS:
+		return i18n.LANGUAGEMAPPING.GitConflictEditorResolutionTheirs
+	case git.CONFLICTBLOCKBOTH:
+		return i18n.LANGUAGEMAPPING.GitConflictEditorResolutionBoth
+	case git.CONFLICTBLOCKBASE:
+		return i18n.LANGUAGEMAPPING.GitConflictEditorResolutionBase
+	}
+	return i18n.LANGUAGEMAPPING.GitConflictEditorResolutionNone
+}
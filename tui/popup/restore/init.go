@@ -0,0 +1,161 @@
+package restore
+
+import (
+	"fmt"
+
+	"charm.land/bubbles/v2/list"
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+	"github.com/gohyuhan/gitti/tui/utils"
+)
+
+// for the revision list that the modified file can be restored from
+func InitGitRestoreSourcePopUpModel(m *types.GittiModel, filePathName string) {
+	items := []list.Item{}
+	for _, restoreSource := range m.GitOperations.GitFiles.RestoreSources() {
+		revisionName := restoreSource.Revision
+		if restoreSource.SourceType == git.RESTORESOURCECOMMIT {
+			revisionName = restoreSource.Hash
+		}
+		items = append(items, GitRestoreSourceItem{
+			Name:         RestoreSourceTypeName(restoreSource.SourceType),
+			Revision:     restoreSource.Revision,
+			RevisionName: revisionName,
+			Info:         fmt.Sprintf("%s %s", restoreSource.Hash, restoreSource.Subject),
+		})
+	}
+
+	width := (min(constant.MaxGitRestoreSourcePopUpWidth, int(float64(m.Width)*0.8)) - 4)
+	rSL := list.New(items, GitRestoreSourceDelegate{}, width, constant.PopUpGitRestoreSourcePopUpHeight)
+	rSL.SetShowPagination(false)
+	rSL.SetShowStatusBar(false)
+	rSL.SetFilteringEnabled(false)
+	rSL.SetShowTitle(false)
+
+	// Custom Help Model for Count Display
+	rSL.SetShowHelp(true)
+	rSL.KeyMap = list.KeyMap{}
+	rSL.Styles.HelpStyle = style.NewStyle.MarginTop(0).MarginBottom(0).PaddingTop(0).PaddingBottom(0)
+	rSL.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &rSL, constant.MaxGitRestoreSourcePopUpWidth)
+
+	popUpModel := &GitRestoreSourcePopUpModel{
+		FilePathName:      filePathName,
+		RestoreSourceList: rSL,
+	}
+
+	m.PopUpModel = popUpModel
+}
+
+// for the list of files changed by the commit, the chosen one will be restored as of the commit
+func InitGitRestoreFilePopUpModel(m *types.GittiModel, commitHash string, filePathNames []string) {
+	items := make([]list.Item, 0, len(filePathNames))
+	for _, filePathName := range filePathNames {
+		items = append(items, GitRestoreFileItem{FilePathName: filePathName})
+	}
+
+	width := (min(constant.MaxGitRestoreFilePopUpWidth, int(float64(m.Width)*0.8)) - 4)
+	rFL := list.New(items, GitRestoreFileDelegate{}, width, constant.PopUpGitRestoreFilePopUpHeight)
+	rFL.SetShowPagination(false)
+	rFL.SetShowStatusBar(false)
+	rFL.SetFilteringEnabled(false)
+	rFL.SetShowTitle(false)
+
+	// Custom Help Model for Count Display
+	rFL.SetShowHelp(true)
+	rFL.KeyMap = list.KeyMap{}
+	rFL.Styles.HelpStyle = style.NewStyle.MarginTop(0).MarginBottom(0).PaddingTop(0).PaddingBottom(0)
+	rFL.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &rFL, constant.MaxGitRestoreFilePopUpWidth)
+
+	popUpModel := &GitRestoreFilePopUpModel{
+		CommitHash:      commitHash,
+		RestoreFileList: rFL,
+	}
+
+	m.PopUpModel = popUpModel
+}
+
+// for the target option list of the file to be restored
+func InitGitRestoreTargetOptionPopUpModel(m *types.GittiModel, filePathName string, revision string, revisionName string) {
+	items := []list.Item{
+		GitRestoreTargetOptionItem{
+			Name:          i18n.LANGUAGEMAPPING.GitRestoreTargetWorktree,
+			Info:          i18n.LANGUAGEMAPPING.GitRestoreTargetWorktreeInfo,
+			RestoreTarget: git.RESTORETARGETWORKTREE,
+		},
+		GitRestoreTargetOptionItem{
+			Name:          i18n.LANGUAGEMAPPING.GitRestoreTargetStaged,
+			Info:          i18n.LANGUAGEMAPPING.GitRestoreTargetStagedInfo,
+			RestoreTarget: git.RESTORETARGETSTAGED,
+		},
+		GitRestoreTargetOptionItem{
+			Name:          i18n.LANGUAGEMAPPING.GitRestoreTargetBoth,
+			Info:          i18n.LANGUAGEMAPPING.GitRestoreTargetBothInfo,
+			RestoreTarget: git.RESTORETARGETBOTH,
+		},
+	}
+
+	width := (min(constant.MaxGitRestoreTargetOptionPopUpWidth, int(float64(m.Width)*0.8)) - 4)
+	rTOL := list.New(items, GitRestoreTargetOptionDelegate{}, width, constant.PopUpGitRestoreTargetOptionPopUpHeight)
+	rTOL.SetShowPagination(false)
+	rTOL.SetShowStatusBar(false)
+	rTOL.SetFilteringEnabled(false)
+	rTOL.SetShowTitle(false)
+
+	// Custom Help Model for Count Display
+	rTOL.SetShowHelp(true)
+	rTOL.KeyMap = list.KeyMap{}
+	rTOL.Styles.HelpStyle = style.NewStyle.MarginTop(0).MarginBottom(0).PaddingTop(0).PaddingBottom(0)
+	rTOL.AdditionalShortHelpKeys = utils.PopUpListCounterHelper(m, &rTOL, constant.MaxGitRestoreTargetOptionPopUpWidth)
+
+	popUpModel := &GitRestoreTargetOptionPopUpModel{
+		FilePathName:            filePathName,
+		Revision:                revision,
+		RevisionName:            revisionName,
+		RestoreTargetOptionList: rTOL,
+	}
+
+	m.PopUpModel = popUpModel
+}
+
+func InitGitRestoreConfirmPromptPopUpModel(m *types.GittiModel, filePathName string, revision string, revisionName string, restoreTarget string, isExistsInRevision bool) {
+	popUpModel := &GitRestoreConfirmPromptPopUpModel{
+		FilePathName:       filePathName,
+		Revision:           revision,
+		RevisionName:       revisionName,
+		RestoreTarget:      restoreTarget,
+		IsExistsInRevision: isExistsInRevision,
+	}
+
+	m.PopUpModel = popUpModel
+}
+
+// the name of the kind of revision to be shown to the user
+func RestoreSourceTypeName(sourceType string) string {
+	switch sourceType {
+	case git.RESTORESOURCEBRANCH:
+		return i18n.LANGUAGEMAPPING.GitRestoreSourceBranch
+	case git.RESTORESOURCEREMOTEBRANCH:
+		return i18n.LANGUAGEMAPPING.GitRestoreSourceRemoteBranch
+	case git.RESTORESOURCETAG:
+		return i18n.LANGUAGEMAPPING.GitRestoreSourceTag
+	case git.RESTORESOURCECOMMIT:
+		return i18n.LANGUAGEMAPPING.GitRestoreSourceCommit
+	}
+	return ""
+}
+
+// the name of the restore target to be shown to the user
+func RestoreTargetName(restoreTarget string) string {
+	switch restoreTarget {
+	case git.RESTORETARGETWORKTREE:
+		return i18n.LANGUAGEMAPPING.GitRestoreTargetWorktree
+	case git.RESTORETARGETSTAGED:
+		return i18n.LANGUAGEMAPPING.GitRestoreTargetStaged
+	case git.RESTORETARGETBOTH:
+		return i18n.LANGUAGEMAPPING.GitRestoreTargetBoth
+	}
+	return ""
+}
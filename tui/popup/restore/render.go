@@ -0,0 +1,91 @@
+package restore
+
+import (
+	"fmt"
+
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+
+	"charm.land/lipgloss/v2"
+)
+
+// ------------------------------------
+//
+//	For restoring a file as of a branch, tag or commit
+//
+// ------------------------------------
+// choose the revision to restore the modified file from
+func RenderGitRestoreSourcePopUp(m *types.GittiModel) string {
+	popUp, ok := m.PopUpModel.(*GitRestoreSourcePopUpModel)
+	if ok {
+		popUpWidth := min(constant.MaxGitRestoreSourcePopUpWidth, int(float64(m.Width)*0.8))
+		title := style.TitleStyle.Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitRestoreSourceTitle, popUp.FilePathName))
+		popUp.RestoreSourceList.SetWidth(popUpWidth - 4)
+		content := lipgloss.JoinVertical(
+			lipgloss.Left,
+			title,
+			popUp.RestoreSourceList.View(),
+		)
+		return style.PopUpBorderStyle.Width(popUpWidth).Render(content)
+	}
+	return ""
+}
+
+// choose the file of the commit to be restored
+func RenderGitRestoreFilePopUp(m *types.GittiModel) string {
+	popUp, ok := m.PopUpModel.(*GitRestoreFilePopUpModel)
+	if ok {
+		popUpWidth := min(constant.MaxGitRestoreFilePopUpWidth, int(float64(m.Width)*0.8))
+		title := style.TitleStyle.Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitRestoreFileTitle, ShortRevision(popUp.CommitHash)))
+		popUp.RestoreFileList.SetWidth(popUpWidth - 4)
+		content := lipgloss.JoinVertical(
+			lipgloss.Left,
+			title,
+			popUp.RestoreFileList.View(),
+		)
+		return style.PopUpBorderStyle.Width(popUpWidth).Render(content)
+	}
+	return ""
+}
+
+// choose where the file will be restored to
+func RenderGitRestoreTargetOptionPopUp(m *types.GittiModel) string {
+	popUp, ok := m.PopUpModel.(*GitRestoreTargetOptionPopUpModel)
+	if ok {
+		popUpWidth := min(constant.MaxGitRestoreTargetOptionPopUpWidth, int(float64(m.Width)*0.8))
+		title := style.TitleStyle.Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitRestoreTargetOptionTitle, popUp.FilePathName, popUp.RevisionName))
+		popUp.RestoreTargetOptionList.SetWidth(popUpWidth - 4)
+		content := lipgloss.JoinVertical(
+			lipgloss.Left,
+			title,
+			popUp.RestoreTargetOptionList.View(),
+		)
+		return style.PopUpBorderStyle.Width(popUpWidth).Render(content)
+	}
+	return ""
+}
+
+func RenderGitRestoreConfirmPromptPopUp(m *types.GittiModel) string {
+	popUp, ok := m.PopUpModel.(*GitRestoreConfirmPromptPopUpModel)
+	if ok {
+		popUpWidth := min(constant.MaxGitRestoreConfirmPromptPopUpWidth, int(float64(m.Width)*0.8))
+		var content string
+		if popUp.IsExistsInRevision {
+			content = style.NewStyle.Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitRestoreOverwriteConfirmation, popUp.FilePathName, popUp.RevisionName, RestoreTargetName(popUp.RestoreTarget)))
+		} else {
+			content = style.NewStyle.Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.GitRestoreRemoveConfirmation, popUp.FilePathName, popUp.RevisionName, RestoreTargetName(popUp.RestoreTarget)))
+		}
+		return style.PopUpBorderStyle.Width(popUpWidth).Render(content)
+	}
+	return ""
+}
+
+// the abbreviated commit hash to be shown to the user
+func ShortRevision(commitHash string) string {
+	if len(commitHash) > 7 {
+		return commitHash[:7]
+	}
+	return commitHash
+}
@@ -0,0 +1,210 @@
+package restore
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"charm.land/bubbles/v2/list"
+	tea "charm.land/bubbletea/v2"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/utils"
+
+	"charm.land/lipgloss/v2"
+)
+
+// ---------------------------------
+//
+// choose the branch, tag or recent commit that the modified file will be restored from
+//
+// ---------------------------------
+type GitRestoreSourcePopUpModel struct {
+	FilePathName      string
+	RestoreSourceList list.Model
+}
+
+// ---------------------------------
+//
+// choose the file changed by the commit that will be restored as of the commit
+//
+// ---------------------------------
+type GitRestoreFilePopUpModel struct {
+	CommitHash      string
+	RestoreFileList list.Model
+}
+
+// ---------------------------------
+//
+// choose whether the file will be restored to the working tree, the index or both
+//
+// ---------------------------------
+type GitRestoreTargetOptionPopUpModel struct {
+	FilePathName            string
+	Revision                string
+	RevisionName            string // the revision to be shown to the user, the abbreviated hash for a commit
+	RestoreTargetOptionList list.Model
+}
+
+// ---------------------------------
+//
+// # To prompt user for confirmation, only when the local changes will be overwritten or the file will be removed
+//
+// ---------------------------------
+type GitRestoreConfirmPromptPopUpModel struct {
+	FilePathName       string
+	Revision           string
+	RevisionName       string
+	RestoreTarget      string
+	IsExistsInRevision bool // the file will be removed when it doesn't exist in the revision
+}
+
+// ---------------------------------
+//
+// for restore source selection
+//
+// ---------------------------------
+type (
+	GitRestoreSourceDelegate struct{}
+	GitRestoreSourceItem     struct {
+		Name         string // the kind of the revision
+		Revision     string
+		RevisionName string // the abbreviated hash for a commit
+		Info         string
+	}
+)
+
+func (i GitRestoreSourceItem) FilterValue() string {
+	return i.Revision
+}
+
+// ---------------------------------
+//
+// for restore file selection
+//
+// ---------------------------------
+type (
+	GitRestoreFileDelegate struct{}
+	GitRestoreFileItem     struct {
+		FilePathName string
+	}
+)
+
+func (i GitRestoreFileItem) FilterValue() string {
+	return i.FilePathName
+}
+
+// ---------------------------------
+//
+// for restore target selection option
+//
+// ---------------------------------
+type (
+	GitRestoreTargetOptionDelegate struct{}
+	GitRestoreTargetOptionItem     struct {
+		Name          string
+		Info          string
+		RestoreTarget string
+	}
+)
+
+func (i GitRestoreTargetOptionItem) FilterValue() string {
+	return i.Name
+}
+
+// for restore source selection, one line for each as there can be many of them
+func (d GitRestoreSourceDelegate) Height() int                             { return 1 }
+func (d GitRestoreSourceDelegate) Spacing() int                            { return 0 }
+func (d GitRestoreSourceDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+func (d GitRestoreSourceDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	i, ok := listItem.(GitRestoreSourceItem)
+	if !ok {
+		return
+	}
+
+	componentWidth := m.Width() - constant.ListItemOrTitleWidthPad - 2
+
+	nameStr := fmt.Sprintf(" [%s] %s", i.Name, i.RevisionName)
+	nameStr = utils.TruncateString(nameStr, componentWidth)
+	infoStr := utils.TruncateString("  "+i.Info, max(componentWidth-lipgloss.Width(nameStr), 0))
+
+	nameRendered := style.ItemStyle.Render(nameStr)
+	infoRendered := style.ItemStyle.Faint(true).Render(infoStr)
+	fullStr := nameRendered + infoRendered
+
+	var fn func(...string) string
+	if index == m.Index() {
+		fn = func(s ...string) string {
+			return style.SelectedItemStyle.Render("❯ " + strings.Join(s, " "))
+		}
+	} else {
+		fn = func(s ...string) string {
+			return style.ItemStyle.Render("  " + strings.Join(s, " "))
+		}
+	}
+
+	fmt.Fprint(w, fn(fullStr))
+}
+
+// for restore file selection
+func (d GitRestoreFileDelegate) Height() int                             { return 1 }
+func (d GitRestoreFileDelegate) Spacing() int                            { return 0 }
+func (d GitRestoreFileDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+func (d GitRestoreFileDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	i, ok := listItem.(GitRestoreFileItem)
+	if !ok {
+		return
+	}
+
+	componentWidth := m.Width() - constant.ListItemOrTitleWidthPad - 2
+	fileStr := utils.TruncateString(" "+i.FilePathName, componentWidth)
+
+	var fn func(...string) string
+	if index == m.Index() {
+		fn = func(s ...string) string {
+			return style.SelectedItemStyle.Render("❯ " + strings.Join(s, " "))
+		}
+	} else {
+		fn = func(s ...string) string {
+			return style.ItemStyle.Render("  " + strings.Join(s, " "))
+		}
+	}
+
+	fmt.Fprint(w, fn(fileStr))
+}
+
+// for restore target selection
+func (d GitRestoreTargetOptionDelegate) Height() int                             { return 1 }
+func (d GitRestoreTargetOptionDelegate) Spacing() int                            { return 0 }
+func (d GitRestoreTargetOptionDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+func (d GitRestoreTargetOptionDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	i, ok := listItem.(GitRestoreTargetOptionItem)
+	if !ok {
+		return
+	}
+
+	nameStr := fmt.Sprintf("   %s", i.Name)
+	infoStr := fmt.Sprintf("    %s", i.Info)
+
+	componentWidth := m.Width() - constant.ListItemOrTitleWidthPad - 2
+
+	nameStr = utils.TruncateString(nameStr, componentWidth)
+	infoStr = utils.TruncateString(infoStr, componentWidth)
+
+	nameRendered := style.ItemStyle.Render(nameStr)
+	infoRendered := style.ItemStyle.Faint(true).Render(infoStr)
+	fullStr := nameRendered + "\n" + "  " + infoRendered
+
+	var fn func(...string) string
+	if index == m.Index() {
+		fn = func(s ...string) string {
+			return style.SelectedItemStyle.Render("❯ " + strings.Join(s, " "))
+		}
+	} else {
+		fn = func(s ...string) string {
+			return style.ItemStyle.Render("  " + strings.Join(s, " "))
+		}
+	}
+
+	fmt.Fprint(w, fn(fullStr))
+}
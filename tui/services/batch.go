@@ -0,0 +1,112 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/tui/constant"
+	batchPopUp "github.com/gohyuhan/gitti/tui/popup/batch"
+	"github.com/gohyuhan/gitti/tui/types"
+)
+
+// services was to bridge api and the needs of the terminal interface logic so that it can be compatible and feels smooth and not clunky
+// ------------------------------------
+//
+//	For Git stage or unstage the marked files
+//
+// ------------------------------------
+func GitStageOrUnstageFilesService(m *types.GittiModel, filePathNames []string) {
+	go func() {
+		m.GitOperations.GitFiles.StageOrUnstageFiles(filePathNames)
+	}()
+}
+
+// ------------------------------------
+//
+//	For Git stage or unstage the files matched by a pathspec
+//
+// ------------------------------------
+func GitStageFilesService(m *types.GittiModel, filePathNames []string) {
+	go func() {
+		m.GitOperations.GitFiles.StageFiles(filePathNames)
+	}()
+}
+
+func GitUnstageFilesService(m *types.GittiModel, filePathNames []string) {
+	go func() {
+		m.GitOperations.GitFiles.UnstageFiles(filePathNames)
+	}()
+}
+
+// ------------------------------------
+//
+//	For Git batch operation on the marked items
+//	* the items are run one by one (except for files, which is done within a single git command)
+//	  and the output of all of them will be shown in the same pop up
+//
+// ------------------------------------
+func GitBatchOperationService(m *types.GittiModel, batchOperationType string, items []string) {
+	go func() {
+		var resultOutput []string
+		success := true
+
+		switch batchOperationType {
+		case constant.BATCHDISCARDFILES:
+			resultOutput, success = m.GitOperations.GitFiles.DiscardFilesChanges(items)
+		case constant.BATCHSTASHFILES:
+			filesStatus := []git.FileStatus{}
+			for _, filePathName := range items {
+				if fileStatus, fileExist := m.GitOperations.GitFiles.FileStatusOf(filePathName); fileExist {
+					filesStatus = append(filesStatus, fileStatus)
+				}
+			}
+			var exitStatusCode int
+			resultOutput, exitStatusCode = m.GitOperations.GitStash.GitStashFiles(filesStatus, "")
+			success = exitStatusCode == 0
+		case constant.BATCHDELETEBRANCHES:
+			for _, branchName := range items {
+				output, deleteSuccess := m.GitOperations.GitBranch.DeleteLocalBranch(branchName)
+				resultOutput = append(resultOutput, fmt.Sprintf("[%s]", branchName))
+				resultOutput = append(resultOutput, output...)
+				success = success && deleteSuccess
+			}
+		case constant.BATCHDROPSTASHES:
+			for _, stashId := range items {
+				output, exitStatusCode := m.GitOperations.GitStash.GitStashDrop(stashId)
+				resultOutput = append(resultOutput, fmt.Sprintf("[%s]", stashId))
+				resultOutput = append(resultOutput, output...)
+				success = success && exitStatusCode == 0
+			}
+		}
+
+		setGitBatchOperationOutput(m, resultOutput, success)
+	}()
+}
+
+// ------------------------------------
+//
+//	For Git clean on the checked entries of the clean pop up, the output is shown in the batch output pop up
+//
+// ------------------------------------
+func GitCleanService(m *types.GittiModel, entries []string, includeDirectories bool, ignoredMode string) {
+	go func() {
+		resultOutput, success := m.GitOperations.GitFiles.GitCleanEntries(entries, includeDirectories, ignoredMode)
+		setGitBatchOperationOutput(m, resultOutput, success)
+	}()
+}
+
+func setGitBatchOperationOutput(m *types.GittiModel, resultOutput []string, success bool) {
+	popUp, ok := m.PopUpModel.(*batchPopUp.GitBatchOperationOutputPopUpModel)
+	if ok {
+		if success {
+			popUp.HasError.Store(false)
+			popUp.ProcessSuccess.Store(true)
+		} else {
+			popUp.HasError.Store(true)
+			popUp.ProcessSuccess.Store(false)
+		}
+		popUp.IsProcessing.Store(false)
+		popUp.GitBatchOperationOutputViewport.SetContentLines(resultOutput)
+		popUp.GitBatchOperationOutputViewport.PageDown()
+	}
+}
@@ -0,0 +1,231 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/viewport"
+	"charm.land/lipgloss/v2"
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/component/commitlog"
+	"github.com/gohyuhan/gitti/tui/component/files"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+	"github.com/gohyuhan/gitti/tui/utils"
+)
+
+const blameAuthorMaxWidth = 20
+
+// for modified file blame detail panel view
+// the position of the previous cursor will be carried over if it was pointing to the same file
+func generateModifiedFileBlameDetailPanelCursor(ctx context.Context, m *types.GittiModel) *types.DetailPanelBlameCursor {
+	currentSelectedModifiedFile := m.CurrentRepoModifiedFilesInfoList.SelectedItem()
+	selectedFile, ok := currentSelectedModifiedFile.(files.GitModifiedFilesItem)
+	if !ok {
+		return nil
+	}
+
+	blameCursor := &types.DetailPanelBlameCursor{
+		FilePathName: selectedFile.FilePathname,
+		Title:        fmt.Sprintf("[ %s ]  %s\n\n", selectedFile.FilePathname, i18n.LANGUAGEMAPPING.BlameTitle),
+		BlameLines:   m.GitOperations.GitBlame.GetFileBlame(ctx, selectedFile.FilePathname),
+	}
+	if previousBlameCursor := m.DetailPanelBlameCursor; previousBlameCursor != nil && previousBlameCursor.FilePathName == blameCursor.FilePathName {
+		blameCursor.LineIndex = min(previousBlameCursor.LineIndex, max(len(blameCursor.BlameLines)-1, 0))
+	}
+	return blameCursor
+}
+
+// render the blame with the commit hash, author and relative date in front of every line,
+// the cursor will only be drawn when the panel is focused
+func renderDetailPanelBlameCursorContent(blameCursor *types.DetailPanelBlameCursor, isFocused bool) string {
+	var vpLine strings.Builder
+	vpLine.WriteString(blameCursor.Title)
+
+	if blameCursor.BlameLines == nil {
+		vpLine.WriteString(i18n.LANGUAGEMAPPING.BlameUnavailable)
+		return vpLine.String()
+	}
+
+	now := time.Now()
+	authors := make([]string, len(blameCursor.BlameLines))
+	relativeDates := make([]string, len(blameCursor.BlameLines))
+	authorWidth, relativeDateWidth, lineNumberWidth := 0, 0, 0
+	commitColorIDs := make(map[string]int)
+	for index, blameLine := range blameCursor.BlameLines {
+		if blameLine.IsUncommitted {
+			authors[index] = i18n.LANGUAGEMAPPING.BlameNotCommittedYet
+		} else {
+			authors[index] = blameLine.Author
+			relativeDates[index] = formatRelativeTime(blameLine.AuthorTime, now)
+		}
+		authors[index] = utils.TruncateString(authors[index], blameAuthorMaxWidth)
+		authorWidth = max(authorWidth, lipgloss.Width(authors[index]))
+		relativeDateWidth = max(relativeDateWidth, lipgloss.Width(relativeDates[index]))
+		lineNumberWidth = max(lineNumberWidth, len(strconv.Itoa(blameLine.FinalLineNumber)))
+		if _, exist := commitColorIDs[blameLine.Hash]; !exist {
+			commitColorIDs[blameLine.Hash] = len(commitColorIDs)
+		}
+	}
+
+	language := style.SyntaxLanguageOf(blameCursor.FilePathName)
+	for index, blameLine := range blameCursor.BlameLines {
+		if isFocused && index == blameCursor.LineIndex {
+			vpLine.WriteString(style.DiffCursorStyle.Render("▌"))
+		} else {
+			vpLine.WriteString(" ")
+		}
+
+		commitStyle := style.NewStyle.Foreground(style.GetColor(commitColorIDs[blameLine.Hash]))
+		if blameLine.IsUncommitted {
+			commitStyle = style.DiffLineNumberStyle
+		}
+		vpLine.WriteString(style.NewStyle.Foreground(style.ColorYellowWarm).Render(blameLine.Hash[:min(7, len(blameLine.Hash))]))
+		vpLine.WriteString(" ")
+		vpLine.WriteString(commitStyle.Render(authors[index] + strings.Repeat(" ", authorWidth-lipgloss.Width(authors[index]))))
+		vpLine.WriteString(" ")
+		vpLine.WriteString(style.DiffLineNumberStyle.Render(relativeDates[index] + strings.Repeat(" ", relativeDateWidth-lipgloss.Width(relativeDates[index]))))
+		vpLine.WriteString(" ")
+		vpLine.WriteString(style.DiffLineNumberStyle.Render(fmt.Sprintf("%*d", lineNumberWidth, blameLine.FinalLineNumber)))
+		vpLine.WriteString(" ")
+		if language != nil {
+			for _, token := range style.TokenizeSyntax(blameLine.Content, language) {
+				vpLine.WriteString(style.SyntaxTokenStyle(token.Kind).Render(token.Text))
+			}
+		} else {
+			vpLine.WriteString(style.NewStyle.Render(blameLine.Content))
+		}
+		vpLine.WriteString("\n")
+	}
+	return vpLine.String()
+}
+
+// ------------------------------------
+//
+//	For moving the blame cursor within the detail component panel
+//	* return false if there is no blame cursor to move, so the caller can fallback to scrolling the viewport
+//
+// ------------------------------------
+func MoveDetailPanelBlameCursor(m *types.GittiModel, step int) bool {
+	blameCursor := m.DetailPanelBlameCursor
+	if blameCursor == nil || m.CurrentSelectedComponent != constant.DetailComponent || len(blameCursor.BlameLines) < 1 {
+		return false
+	}
+
+	blameCursor.LineIndex = min(max(blameCursor.LineIndex+step, 0), len(blameCursor.BlameLines)-1)
+	RefreshDetailPanelDiffCursorContent(m)
+	scrollDetailPanelBlameCursorIntoView(blameCursor, &m.DetailPanelViewport)
+	return true
+}
+
+// ------------------------------------
+//
+//	For jumping to the commit that last touched the line under the blame cursor
+//	* the commit log component will be selected with the commit being selected
+//	* the pages that were not loaded yet will be loaded in the background, the commit is selected once they were loaded
+//	* return false if the commit is not within the loaded commit log (eg, the line is not committed yet)
+//
+// ------------------------------------
+func JumpToDetailPanelBlameCursorCommit(m *types.GittiModel) bool {
+	blameCursor := m.DetailPanelBlameCursor
+	if blameCursor == nil || blameCursor.LineIndex >= len(blameCursor.BlameLines) {
+		return false
+	}
+	blameLine := blameCursor.BlameLines[blameCursor.LineIndex]
+	if blameLine.IsUncommitted {
+		return false
+	}
+
+	if selectCommitLogItem(m, blameLine.Hash) {
+		focusJumpedCommitLogItem(m)
+		return true
+	}
+
+	// only one jump is loaded at a time
+	if m.CommitLogJumpHash != "" {
+		return false
+	}
+	m.CommitLogJumpHash = blameLine.Hash
+	go func() {
+		m.GitOperations.GitCommitLog.LoadCommitLogPagesUntil(blameLine.Hash)
+		m.TuiUpdateChannel <- constant.COMMIT_LOG_JUMP_LOADED
+	}()
+	return false
+}
+
+// ------------------------------------
+//
+//	For selecting the commit being jumped to once the commit log pages were loaded up to it
+//	* the filter and scope are kept when the commit is not within them, it is noted on the commit log title instead
+//
+// ------------------------------------
+func CommitLogJumpLoadedService(m *types.GittiModel) {
+	commitHash := m.CommitLogJumpHash
+	m.CommitLogJumpHash = ""
+	if commitHash == "" {
+		return
+	}
+
+	isLoaded := slices.ContainsFunc(m.GitOperations.GitCommitLog.GitCommitLogOutput(), func(cL git.CommitLog) bool { return cL.Hash == commitHash })
+	if !isLoaded {
+		m.CommitLogJumpNotFoundHash = commitHash
+	}
+	commitlog.InitGitCommitLogList(m)
+	if isLoaded && selectCommitLogItem(m, commitHash) {
+		focusJumpedCommitLogItem(m)
+	}
+}
+
+// the commit log component is selected with the commit log (not the files of a commit) being shown
+func focusJumpedCommitLogItem(m *types.GittiModel) {
+	m.CommitLogJumpNotFoundHash = ""
+	m.CurrentSelectedComponent = constant.CommitLogComponent
+	m.IsCommitLogFilesView = false
+	FetchDetailComponentPanelInfoService(m, true)
+}
+
+// select the commit within the loaded commit log, return false if it was not loaded
+func selectCommitLogItem(m *types.GittiModel, commitHash string) bool {
+	for index, item := range m.CurrentRepoCommitLogInfoList.Items() {
+		if commitLogItem, ok := item.(commitlog.GitCommitLogItem); ok && commitLogItem.Hash == commitHash {
+			m.CurrentRepoCommitLogInfoList.Select(index)
+			m.ListNavigationIndexPosition.CommitLogComponent = index
+			return true
+		}
+	}
+	return false
+}
+
+// scroll the viewport so that the line under the cursor is visible
+func scrollDetailPanelBlameCursorIntoView(blameCursor *types.DetailPanelBlameCursor, vp *viewport.Model) {
+	cursorLine := blameCursor.LineIndex + strings.Count(blameCursor.Title, "\n")
+	if cursorLine < vp.YOffset() {
+		vp.SetYOffset(cursorLine)
+	} else if cursorLine >= vp.YOffset()+vp.Height() {
+		vp.SetYOffset(cursorLine - vp.Height() + 1)
+	}
+}
+
+// the time that has passed since the given time, eg, 3 days ago
+func formatRelativeTime(t time.Time, now time.Time) string {
+	elapsed := now.Sub(t)
+	switch {
+	case elapsed < time.Minute:
+		return i18n.LANGUAGEMAPPING.RelativeTimeJustNow
+	case elapsed < time.Hour:
+		return fmt.Sprintf(i18n.LANGUAGEMAPPING.RelativeTimeMinutesAgo, int(elapsed/time.Minute))
+	case elapsed < 24*time.Hour:
+		return fmt.Sprintf(i18n.LANGUAGEMAPPING.RelativeTimeHoursAgo, int(elapsed/time.Hour))
+	case elapsed < 30*24*time.Hour:
+		return fmt.Sprintf(i18n.LANGUAGEMAPPING.RelativeTimeDaysAgo, int(elapsed/(24*time.Hour)))
+	case elapsed < 365*24*time.Hour:
+		return fmt.Sprintf(i18n.LANGUAGEMAPPING.RelativeTimeMonthsAgo, int(elapsed/(30*24*time.Hour)))
+	}
+	return fmt.Sprintf(i18n.LANGUAGEMAPPING.RelativeTimeYearsAgo, int(elapsed/(365*24*time.Hour)))
+}
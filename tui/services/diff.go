@@ -0,0 +1,148 @@
+package services
+
+import (
+	"image/color"
+	"regexp"
+	"strings"
+
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/tui/style"
+)
+
+var diffAnsiEscapeSequenceRegex = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+
+// ----------------------------------
+//
+//	Highlight the lines within the hunks of a diff
+//	* the syntax of the file is highlighted based on the file extension found in the `---`/`+++` header,
+//	  a diff that contain multiple files (git show, git stash show -p) will be highlighted per file
+//	* only the changed words of the removed and added lines that replaced each other will be emphasized
+//	* every line stay at the same position, so the raw index of the parsed diff still point to the same line
+//
+// ----------------------------------
+func highlightDiffLines(diffLines []string) []string {
+	if diffLines == nil {
+		return nil
+	}
+
+	// the word changes of every paired removed and added line, keyed by the raw index
+	wordChanges := make(map[int][]git.WordDiffSegment)
+	for _, pair := range git.PairDiffChangeLines(diffLines) {
+		oldSegments, newSegments, ok := git.DiffLineWordChanges(pair.OldContent, pair.NewContent)
+		if !ok {
+			continue
+		}
+		wordChanges[pair.OldRawIndex] = oldSegments
+		wordChanges[pair.NewRawIndex] = newSegments
+	}
+
+	highlightedLines := make([]string, len(diffLines))
+	copy(highlightedLines, diffLines)
+	var language *style.SyntaxLanguage
+	inHunk := false
+	for index, rawLine := range diffLines {
+		line := diffAnsiEscapeSequenceRegex.ReplaceAllString(rawLine, "")
+
+		kind := ""
+		if len(line) > 0 {
+			kind = line[:1]
+		}
+		if inHunk && kind != "+" && kind != "-" && kind != " " && kind != "\\" && kind != "" && !strings.HasPrefix(line, "@@") {
+			inHunk = false
+		}
+
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			inHunk = true
+		case !inHunk:
+			switch {
+			case strings.HasPrefix(line, "diff "):
+				language = nil
+			case strings.HasPrefix(line, "--- "), strings.HasPrefix(line, "+++ "):
+				if filePathName := strings.TrimRight(line[4:], "\t"); filePathName != "/dev/null" {
+					language = style.SyntaxLanguageOf(strings.Trim(filePathName, "\""))
+				}
+			}
+		case kind == "\\":
+			// no newline at end of file marker, keep it as how git colored it
+		case language != nil:
+			content := ""
+			if len(line) > 0 {
+				content = line[1:]
+			}
+			highlightedLines[index] = renderSyntaxDiffLine(kind, content, language, wordChanges[index])
+		case wordChanges[index] != nil:
+			highlightedLines[index] = renderWordDiffLine(kind, wordChanges[index])
+		}
+	}
+	return highlightedLines
+}
+
+// render the line with the syntax color, removed and added line will get a background while the changed words get a stronger one
+func renderSyntaxDiffLine(kind string, content string, language *style.SyntaxLanguage, wordSegments []git.WordDiffSegment) string {
+	var lineBackground color.Color
+	var wordBackground color.Color
+	kindStyle := style.NewStyle
+	switch kind {
+	case "-":
+		lineBackground, wordBackground = style.ColorRedSubtle, style.ColorRedDeep
+		kindStyle = style.DiffOldLineStyle.Background(lineBackground)
+	case "+":
+		lineBackground, wordBackground = style.ColorGreenSubtle, style.ColorGreenDeep
+		kindStyle = style.DiffNewLineStyle.Background(lineBackground)
+	default:
+		kind = " "
+	}
+	if wordSegments == nil {
+		wordSegments = []git.WordDiffSegment{{Text: content, IsChanged: false}}
+	}
+
+	var line strings.Builder
+	line.WriteString(kindStyle.Render(kind))
+	tokens := style.TokenizeSyntax(content, language)
+	tokenIndex, tokenOffset := 0, 0
+	for _, segment := range wordSegments {
+		// split the tokens at the boundary of the word segments
+		remaining := len(segment.Text)
+		for remaining > 0 && tokenIndex < len(tokens) {
+			token := tokens[tokenIndex]
+			pieceLength := min(remaining, len(token.Text)-tokenOffset)
+			piece := token.Text[tokenOffset : tokenOffset+pieceLength]
+
+			pieceStyle := style.SyntaxTokenStyle(token.Kind)
+			if segment.IsChanged && wordBackground != nil {
+				pieceStyle = pieceStyle.Background(wordBackground).Bold(true)
+			} else if lineBackground != nil {
+				pieceStyle = pieceStyle.Background(lineBackground)
+			}
+			line.WriteString(pieceStyle.Render(piece))
+
+			remaining -= pieceLength
+			tokenOffset += pieceLength
+			if tokenOffset >= len(token.Text) {
+				tokenIndex++
+				tokenOffset = 0
+			}
+		}
+	}
+	return line.String()
+}
+
+// render the line with the plain diff color, only the changed words get a background
+func renderWordDiffLine(kind string, segments []git.WordDiffSegment) string {
+	lineStyle, wordStyle := style.DiffNewLineStyle, style.DiffNewWordStyle
+	if kind == "-" {
+		lineStyle, wordStyle = style.DiffOldLineStyle, style.DiffOldWordStyle
+	}
+
+	var line strings.Builder
+	line.WriteString(lineStyle.Render(kind))
+	for _, segment := range segments {
+		if segment.IsChanged {
+			line.WriteString(wordStyle.Render(segment.Text))
+		} else {
+			line.WriteString(lineStyle.Render(segment.Text))
+		}
+	}
+	return line.String()
+}
@@ -1,6 +1,8 @@
 package services
 
 import (
+	tea "charm.land/bubbletea/v2"
+	"github.com/gohyuhan/gitti/api/git"
 	"github.com/gohyuhan/gitti/tui/types"
 )
 
@@ -16,6 +18,17 @@ func GitStageOrUnstageService(m *types.GittiModel, filePathName string) {
 	}()
 }
 
+// ------------------------------------
+//
+//	For Git stage or unstage every file under a directory
+//
+// ------------------------------------
+func GitStageOrUnstageDirectoryService(m *types.GittiModel, directoryPath string) {
+	go func() {
+		m.GitOperations.GitFiles.StageOrUnstageDirectory(directoryPath)
+	}()
+}
+
 // ------------------------------------
 //
 //	For Git Stage All
@@ -48,3 +61,26 @@ func GitDiscardFileChangesService(m *types.GittiModel, filePathName string, disc
 		m.GitOperations.GitFiles.DiscardFileChanges(filePathName, discardType)
 	}()
 }
+
+// ------------------------------------
+//
+//	For Git discard hunk/line changes
+//	* the returned command reports back whether the changes could be applied
+//
+// ------------------------------------
+func GitDiscardPartialFileChangesService(m *types.GittiModel, fileDiff git.FileDiff, selectedLines []git.DiffLinePosition, includeIndex bool) tea.Cmd {
+	return func() tea.Msg {
+		return types.GitApplyPatchFinishedMsg{Err: m.GitOperations.GitFiles.DiscardPartialFileChanges(fileDiff, selectedLines, includeIndex)}
+	}
+}
+
+// ------------------------------------
+//
+//	For Git discard every changes under a directory
+//
+// ------------------------------------
+func GitDiscardDirectoryChangesService(m *types.GittiModel, directoryPath string) {
+	go func() {
+		m.GitOperations.GitFiles.DiscardDirectoryChanges(directoryPath)
+	}()
+}
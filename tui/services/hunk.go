@@ -0,0 +1,301 @@
+package services
+
+import (
+	"strings"
+
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/constant"
+	discardPopUp "github.com/gohyuhan/gitti/tui/popup/discard"
+	patchPopUp "github.com/gohyuhan/gitti/tui/popup/patch"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+)
+
+// services was to bridge api and the needs of the terminal interface logic so that it can be compatible and feels smooth and not clunky
+// ------------------------------------
+//
+//	For Git stage or unstage the hunk/line under the diff cursor
+//	* the returned command reports back whether the changes could be applied
+//
+// ------------------------------------
+func GitStageOrUnstageDiffCursorSelectionService(m *types.GittiModel) tea.Cmd {
+	diffCursor, _ := focusedDetailPanelDiffCursor(m)
+	if diffCursor == nil || diffCursor.IsReadOnly || diffCursor.FileStatus.HasConflict || len(diffCursor.Diff.Hunks) < 1 {
+		return nil
+	}
+
+	fileDiff := diffCursor.Diff
+	selectedLines := diffCursorSelectedLines(diffCursor)
+	// a staged diff (HEAD -> index) can only be unstaged, while the combined diff of a file with no unstaged changes is the staged diff
+	unstage := diffCursor.DiffType == git.GETSTAGEDDIFF ||
+		(diffCursor.DiffType == git.GETCOMBINEDDIFF && diffCursor.FileStatus.WorkTree == " ")
+
+	return func() tea.Msg {
+		if unstage {
+			return types.GitApplyPatchFinishedMsg{Err: m.GitOperations.GitFiles.UnstagePartialFileChanges(fileDiff, selectedLines)}
+		}
+		return types.GitApplyPatchFinishedMsg{Err: m.GitOperations.GitFiles.StagePartialFileChanges(fileDiff, selectedLines)}
+	}
+}
+
+// ------------------------------------
+//
+//	For reporting the hunk/line changes that could not be staged, unstaged or discarded
+//	* it is only shown when no other pop up was opened in the meantime
+//
+// ------------------------------------
+func GitApplyPatchFinishedService(m *types.GittiModel, msg types.GitApplyPatchFinishedMsg) {
+	if msg.Err == nil || m.ShowPopUp.Load() {
+		return
+	}
+	m.PopUpType = constant.GitApplyPatchErrorPopUp
+	patchPopUp.InitGitApplyPatchErrorPopUpModel(m, msg.Err)
+	m.ShowPopUp.Store(true)
+	m.IsTyping.Store(false)
+}
+
+// ------------------------------------
+//
+//	For prompting the discard of the hunk/line under the diff cursor
+//	* return false if the hunk/line under the cursor can't be discarded on its own
+//
+// ------------------------------------
+func InitGitDiscardPartialConfirmPromptPopUpFromDiffCursor(m *types.GittiModel) bool {
+	diffCursor, _ := focusedDetailPanelDiffCursor(m)
+	if diffCursor == nil || diffCursor.IsReadOnly || diffCursor.FileStatus.HasConflict || len(diffCursor.Diff.Hunks) < 1 {
+		return false
+	}
+
+	fileStatus := diffCursor.FileStatus
+	var includeIndex bool
+	switch {
+	case diffCursor.DiffType == git.GETUNSTAGEDDIFF:
+		includeIndex = false
+	case diffCursor.DiffType == git.GETCOMBINEDDIFF && (fileStatus.IndexState == " " || fileStatus.IndexState == "?"):
+		// only unstaged changes
+		includeIndex = false
+	case diffCursor.DiffType == git.GETCOMBINEDDIFF && fileStatus.WorkTree == " " && fileStatus.IndexState != "R" && fileStatus.IndexState != "C":
+		// only staged changes, the index and worktree are the same so both can be reverted together
+		includeIndex = true
+	default:
+		// staged changes that have further unstaged changes on top (or a rename/copy) can't be reverted partially
+		return false
+	}
+
+	cursorStart, cursorEnd := diffCursorRawLineRange(diffCursor)
+	if cursorStart < 0 {
+		return false
+	}
+	previewLines := make([]string, 0, cursorEnd-cursorStart+1)
+	previewLines = append(previewLines, diffCursor.HighlightedDiffLines[cursorStart:cursorEnd+1]...)
+
+	discardPopUp.InitGitDiscardPartialConfirmPromptPopupModel(m, fileStatus.FilePathname, diffCursor.Diff, diffCursorSelectedLines(diffCursor), includeIndex, previewLines)
+	return true
+}
+
+// ------------------------------------
+//
+//	For moving the diff cursor within the focused detail component panel
+//	* return false if there is no diff cursor to move, so the caller can fallback to scrolling the viewport
+//
+// ------------------------------------
+func MoveDetailPanelDiffCursor(m *types.GittiModel, step int) bool {
+	diffCursor, vp := focusedDetailPanelDiffCursor(m)
+	if diffCursor == nil || diffCursor.IsReadOnly || len(diffCursor.Diff.Hunks) < 1 {
+		return false
+	}
+
+	if diffCursor.IsLineMode {
+		allChangeLines := []git.DiffLinePosition{}
+		currentPosition := 0
+		for hunkIndex := range diffCursor.Diff.Hunks {
+			for _, position := range diffCursor.Diff.HunkChangeLines(hunkIndex) {
+				if position.HunkIndex == diffCursor.HunkIndex && position.LineIndex == diffCursor.LineIndex {
+					currentPosition = len(allChangeLines)
+				}
+				allChangeLines = append(allChangeLines, position)
+			}
+		}
+		if len(allChangeLines) > 0 {
+			nextPosition := allChangeLines[min(max(currentPosition+step, 0), len(allChangeLines)-1)]
+			diffCursor.HunkIndex = nextPosition.HunkIndex
+			diffCursor.LineIndex = nextPosition.LineIndex
+		}
+	} else {
+		diffCursor.HunkIndex = min(max(diffCursor.HunkIndex+step, 0), len(diffCursor.Diff.Hunks)-1)
+	}
+	clampDetailPanelDiffCursor(diffCursor)
+	RefreshDetailPanelDiffCursorContent(m)
+	scrollDetailPanelDiffCursorIntoView(diffCursor, vp)
+	return true
+}
+
+// ------------------------------------
+//
+//	For switching the diff cursor between hunk and line mode
+//
+// ------------------------------------
+func ToggleDetailPanelDiffCursorMode(m *types.GittiModel) {
+	diffCursor, vp := focusedDetailPanelDiffCursor(m)
+	if diffCursor == nil || diffCursor.IsReadOnly {
+		return
+	}
+	diffCursor.IsLineMode = !diffCursor.IsLineMode
+	clampDetailPanelDiffCursor(diffCursor)
+	RefreshDetailPanelDiffCursorContent(m)
+	scrollDetailPanelDiffCursorIntoView(diffCursor, vp)
+}
+
+// ------------------------------------
+//
+//	For re-rendering the diff (or blame) of both detail component panel with the latest cursor and focus state
+//
+// ------------------------------------
+func RefreshDetailPanelDiffCursorContent(m *types.GittiModel) {
+	if m.DetailPanelDiffCursor != nil {
+		m.DetailPanelViewport.SetContent(renderDetailPanelDiffCursorContent(m.DetailPanelDiffCursor, m.CurrentSelectedComponent == constant.DetailComponent))
+	}
+	if m.DetailPanelTwoDiffCursor != nil && m.ShowDetailPanelTwo.Load() {
+		m.DetailPanelTwoViewport.SetContent(renderDetailPanelDiffCursorContent(m.DetailPanelTwoDiffCursor, m.CurrentSelectedComponent == constant.DetailComponentTwo))
+	}
+	if m.DetailPanelBlameCursor != nil {
+		m.DetailPanelViewport.SetContent(renderDetailPanelBlameCursorContent(m.DetailPanelBlameCursor, m.CurrentSelectedComponent == constant.DetailComponent))
+	}
+}
+
+// return the diff cursor and viewport of the detail component panel that is currently focused
+func focusedDetailPanelDiffCursor(m *types.GittiModel) (*types.DetailPanelDiffCursor, *viewport.Model) {
+	switch m.CurrentSelectedComponent {
+	case constant.DetailComponent:
+		return m.DetailPanelDiffCursor, &m.DetailPanelViewport
+	case constant.DetailComponentTwo:
+		return m.DetailPanelTwoDiffCursor, &m.DetailPanelTwoViewport
+	}
+	return nil, nil
+}
+
+// create the diff cursor for a file diff,
+// the position of the previous cursor will be carried over if it was pointing to the same diff of the same file
+func newDetailPanelDiffCursor(previousDiffCursor *types.DetailPanelDiffCursor, fileStatus git.FileStatus, diffType string, title string, diffLines []string, isReadOnly bool) *types.DetailPanelDiffCursor {
+	diffCursor := &types.DetailPanelDiffCursor{
+		FileStatus:           fileStatus,
+		DiffType:             diffType,
+		Title:                title,
+		DiffLines:            diffLines,
+		HighlightedDiffLines: highlightDiffLines(diffLines),
+		Diff:                 git.ParseFileDiff(diffLines),
+		HunkIndex:            0,
+		LineIndex:            0,
+		IsLineMode:           false,
+		IsReadOnly:           isReadOnly,
+	}
+	if previousDiffCursor != nil {
+		// the line mode will be kept even when moving to another file
+		diffCursor.IsLineMode = previousDiffCursor.IsLineMode
+		if previousDiffCursor.FileStatus.FilePathname == fileStatus.FilePathname && previousDiffCursor.DiffType == diffType {
+			diffCursor.HunkIndex = previousDiffCursor.HunkIndex
+			diffCursor.LineIndex = previousDiffCursor.LineIndex
+		}
+	}
+	clampDetailPanelDiffCursor(diffCursor)
+	return diffCursor
+}
+
+// make sure the cursor point to an existing hunk, and in line mode an existing added or removed line
+func clampDetailPanelDiffCursor(diffCursor *types.DetailPanelDiffCursor) {
+	if len(diffCursor.Diff.Hunks) < 1 {
+		diffCursor.HunkIndex = 0
+		diffCursor.LineIndex = 0
+		return
+	}
+	diffCursor.HunkIndex = min(max(diffCursor.HunkIndex, 0), len(diffCursor.Diff.Hunks)-1)
+
+	changeLines := diffCursor.Diff.HunkChangeLines(diffCursor.HunkIndex)
+	if len(changeLines) < 1 {
+		diffCursor.LineIndex = 0
+		return
+	}
+	for _, position := range changeLines {
+		if position.LineIndex >= diffCursor.LineIndex {
+			diffCursor.LineIndex = position.LineIndex
+			return
+		}
+	}
+	diffCursor.LineIndex = changeLines[len(changeLines)-1].LineIndex
+}
+
+// the lines that will be staged or unstaged for the current cursor position
+func diffCursorSelectedLines(diffCursor *types.DetailPanelDiffCursor) []git.DiffLinePosition {
+	if diffCursor.IsLineMode {
+		return []git.DiffLinePosition{{HunkIndex: diffCursor.HunkIndex, LineIndex: diffCursor.LineIndex}}
+	}
+	return diffCursor.Diff.HunkChangeLines(diffCursor.HunkIndex)
+}
+
+// the first and last index (within DiffLines) of the lines that are under the cursor
+func diffCursorRawLineRange(diffCursor *types.DetailPanelDiffCursor) (int, int) {
+	if len(diffCursor.Diff.Hunks) < 1 {
+		return -1, -1
+	}
+	hunk := diffCursor.Diff.Hunks[diffCursor.HunkIndex]
+	if diffCursor.IsLineMode {
+		if diffCursor.LineIndex >= len(hunk.Lines) {
+			return -1, -1
+		}
+		rawIndex := hunk.Lines[diffCursor.LineIndex].RawIndex
+		return rawIndex, rawIndex
+	}
+	end := hunk.HeaderRawIndex
+	if len(hunk.Lines) > 0 {
+		end = hunk.Lines[len(hunk.Lines)-1].RawIndex
+	}
+	return hunk.HeaderRawIndex, end
+}
+
+// render the diff with a gutter in front of every line, the cursor will only be drawn when the panel is focused
+func renderDetailPanelDiffCursorContent(diffCursor *types.DetailPanelDiffCursor, isFocused bool) string {
+	var vpLine strings.Builder
+	vpLine.WriteString(diffCursor.Title)
+
+	if diffCursor.DiffLines == nil {
+		vpLine.WriteString(i18n.LANGUAGEMAPPING.FileTypeUnSupportedPreview)
+		return vpLine.String()
+	}
+
+	cursorStart, cursorEnd := -1, -1
+	if isFocused && !diffCursor.IsReadOnly {
+		cursorStart, cursorEnd = diffCursorRawLineRange(diffCursor)
+	}
+	hasHunks := len(diffCursor.Diff.Hunks) > 0
+	for index, line := range diffCursor.HighlightedDiffLines {
+		if hasHunks {
+			if index >= cursorStart && index <= cursorEnd {
+				vpLine.WriteString(style.DiffCursorStyle.Render("▌"))
+			} else {
+				vpLine.WriteString(" ")
+			}
+		}
+		vpLine.WriteString(style.NewStyle.Render(line) + "\n")
+	}
+	return vpLine.String()
+}
+
+// scroll the viewport so that the lines under the cursor are visible
+func scrollDetailPanelDiffCursorIntoView(diffCursor *types.DetailPanelDiffCursor, vp *viewport.Model) {
+	cursorStart, cursorEnd := diffCursorRawLineRange(diffCursor)
+	if cursorStart < 0 {
+		return
+	}
+	titleLineCount := strings.Count(diffCursor.Title, "\n")
+	cursorStart += titleLineCount
+	cursorEnd += titleLineCount
+
+	if cursorStart < vp.YOffset() {
+		vp.SetYOffset(cursorStart)
+	} else if cursorEnd >= vp.YOffset()+vp.Height() {
+		vp.SetYOffset(min(cursorStart, cursorEnd-vp.Height()+1))
+	}
+}
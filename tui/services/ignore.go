@@ -0,0 +1,30 @@
+package services
+
+import (
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/tui/types"
+)
+
+// ------------------------------------
+//
+//	For writing the ignore rule of an untracked file
+//
+// ------------------------------------
+func GitWriteIgnoreRuleService(m *types.GittiModel, ignoreRule git.IgnoreRule) {
+	go func() {
+		m.GitOperations.GitFiles.WriteIgnoreRule(ignoreRule)
+	}()
+}
+
+// ------------------------------------
+//
+//	For showing or hiding the ignored files within the modified files panel
+//
+// ------------------------------------
+func GitToggleShowIgnoredFilesService(m *types.GittiModel) {
+	m.GitOperations.GitFiles.SetShowIgnoredFiles(!m.GitOperations.GitFiles.IsShowingIgnoredFiles())
+	go func() {
+		m.GitOperations.GitFiles.GetGitFilesStatus()
+		m.TuiUpdateChannel <- git.GIT_FILES_STATUS_UPDATE
+	}()
+}
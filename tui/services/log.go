@@ -0,0 +1,91 @@
+package services
+
+import (
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/tui/component/commitlog"
+	"github.com/gohyuhan/gitti/tui/constant"
+	"github.com/gohyuhan/gitti/tui/types"
+)
+
+// ------------------------------------
+//
+//	For loading the next page of the commit log when the selection is near the end of the loaded commits
+//
+// ------------------------------------
+func GitLoadNextCommitLogPageService(m *types.GittiModel) {
+	if !m.GitOperations.GitCommitLog.HasMoreCommitLogs() {
+		return
+	}
+	if m.CurrentRepoCommitLogInfoList.Index() < len(m.CurrentRepoCommitLogInfoList.Items())-constant.CommitLogNextPageThreshold {
+		return
+	}
+	go func() {
+		if m.GitOperations.GitCommitLog.LoadNextCommitLogPage() {
+			m.TuiUpdateChannel <- git.GIT_LOG_UPDATE
+		}
+	}()
+}
+
+// ------------------------------------
+//
+//	For filtering the commit log, an empty filter will clear the filter
+//	* return false when the filter can't be applied (eg, -S and -G were both given)
+//
+// ------------------------------------
+func GitCommitLogFilterService(m *types.GittiModel, filter git.CommitLogFilter) bool {
+	if !m.GitOperations.GitCommitLog.SetCommitLogFilter(filter) {
+		return false
+	}
+	m.CommitLogJumpNotFoundHash = ""
+	go func() {
+		m.GitOperations.GitCommitLog.GetCommitLogs()
+		m.TuiUpdateChannel <- git.GIT_LOG_UPDATE
+	}()
+	return true
+}
+
+// ------------------------------------
+//
+//	For changing the history that the commit log graph is drawn for
+//	* return false when no branch was chosen for the selected branches scope
+//
+// ------------------------------------
+func GitCommitLogScopeService(m *types.GittiModel, scope git.CommitLogScope) bool {
+	if !m.GitOperations.GitCommitLog.SetCommitLogScope(scope) {
+		return false
+	}
+	m.CommitLogJumpNotFoundHash = ""
+	go func() {
+		m.GitOperations.GitCommitLog.GetCommitLogs()
+		m.TuiUpdateChannel <- git.GIT_LOG_UPDATE
+	}()
+	return true
+}
+
+// ------------------------------------
+//
+//	For listing the files changed by the commit within the commit log panel, the diff of the selected file will be shown on the detail panel
+//	* the parent number is only given for a merge commit, an empty parent hash is for a root commit
+//
+// ------------------------------------
+func GitCommitLogFilesViewService(m *types.GittiModel, commitHash string, parentHash string, parentNumber int) {
+	m.CommitLogFilesView = types.CommitLogFilesView{
+		CommitHash:   commitHash,
+		ParentHash:   parentHash,
+		ParentNumber: parentNumber,
+	}
+	m.IsCommitLogFilesView = true
+	commitlog.InitGitCommitLogFilesList(m)
+	FetchDetailComponentPanelInfoService(m, true)
+}
+
+// ------------------------------------
+//
+//	For going back from the changed files of a commit to the commit log
+//
+// ------------------------------------
+func GitCommitLogFilesViewExitService(m *types.GittiModel) {
+	m.IsCommitLogFilesView = false
+	m.CommitLogFilesView = types.CommitLogFilesView{}
+	FetchDetailComponentPanelInfoService(m, true)
+}
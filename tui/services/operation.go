@@ -0,0 +1,37 @@
+package services
+
+import (
+	"context"
+
+	operationPopUp "github.com/gohyuhan/gitti/tui/popup/operation"
+	"github.com/gohyuhan/gitti/tui/types"
+)
+
+// ------------------------------------
+//
+//	For continuing, skipping or aborting the operation that the repo is in the middle of
+//
+// ------------------------------------
+func GitRepoOperationService(m *types.GittiModel, operation string, action string) {
+	go func() {
+		popUp, ok := m.PopUpModel.(*operationPopUp.GitRepoOperationOutputPopUpModel)
+		if ok {
+			popUp.HasError.Store(false)
+			popUp.ProcessSuccess.Store(false)
+			popUp.IsProcessing.Store(true)
+		} else {
+			return
+		}
+		exitStatusCode := m.GitOperations.GitRepoOperation.GitRepoOperationAction(context.Background(), operation, action)
+		popUp, ok = m.PopUpModel.(*operationPopUp.GitRepoOperationOutputPopUpModel)
+		if ok {
+			popUp.IsProcessing.Store(false) // update the processing status
+			// if successful exitcode will be 0
+			if exitStatusCode == 0 {
+				popUp.ProcessSuccess.Store(true)
+			} else {
+				popUp.HasError.Store(true)
+			}
+		}
+	}()
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/component/files"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+)
+
+// look into the selected modified file to decide if it should be shown as a binary preview or a paged diff
+func fetchModifiedFilePreview(ctx context.Context, m *types.GittiModel) (git.FileStatus, git.FilePreview, bool) {
+	currentSelectedModifiedFile := m.CurrentRepoModifiedFilesInfoList.SelectedItem()
+	selectedFile, ok := currentSelectedModifiedFile.(files.GitModifiedFilesItem)
+	if !ok {
+		return git.FileStatus{}, git.FilePreview{}, false
+	}
+	fileStatus := git.FileStatus(selectedFile)
+	return fileStatus, m.GitOperations.GitFiles.GetFilePreview(ctx, fileStatus), true
+}
+
+// ----------------------------------
+//
+//	For binary file detail panel view
+//	* the size and the content type of the file before and after the change will be shown instead of the diff,
+//	  an image will also have its dimensions shown
+//
+// ----------------------------------
+func generateModifiedFileBinaryPreviewDetailPanelContent(fileStatus git.FileStatus, filePreview git.FilePreview) string {
+	rows := [][]string{
+		{"", i18n.LANGUAGEMAPPING.FilePreviewBefore, i18n.LANGUAGEMAPPING.FilePreviewAfter},
+		{i18n.LANGUAGEMAPPING.FilePreviewSize, formatFilePreviewSize(filePreview.Old), formatFilePreviewSize(filePreview.New)},
+		{i18n.LANGUAGEMAPPING.FilePreviewMimeType, formatFilePreviewMimeType(filePreview.Old), formatFilePreviewMimeType(filePreview.New)},
+	}
+	if filePreview.Old.ImageFormat != "" || filePreview.New.ImageFormat != "" {
+		rows = append(rows, []string{i18n.LANGUAGEMAPPING.FilePreviewImageDimensions, formatFilePreviewImageDimensions(filePreview.Old), formatFilePreviewImageDimensions(filePreview.New)})
+	}
+
+	columnWidths := make([]int, 3)
+	for _, row := range rows {
+		for column, cell := range row {
+			columnWidths[column] = max(columnWidths[column], lipgloss.Width(cell))
+		}
+	}
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf("[ %s ]\n\n", fileStatus.DisplayPathname()))
+	content.WriteString(style.NewStyle.Foreground(style.ColorYellowWarm).Render(i18n.LANGUAGEMAPPING.FilePreviewBinaryFile) + "\n\n")
+	for rowIndex, row := range rows {
+		var line strings.Builder
+		for column, cell := range row {
+			cellStyle := style.NewStyle
+			if rowIndex == 0 || column == 0 {
+				cellStyle = cellStyle.Bold(true)
+			}
+			line.WriteString(cellStyle.Render(cell + strings.Repeat(" ", columnWidths[column]-lipgloss.Width(cell))))
+			line.WriteString("    ")
+		}
+		content.WriteString(strings.TrimRight(line.String(), " ") + "\n")
+	}
+
+	if filePreview.Old.Exists && filePreview.New.Exists {
+		sizeChange := filePreview.New.Size - filePreview.Old.Size
+		sizeChangeStyle := style.DiffNewLineStyle
+		sign := "+"
+		if sizeChange < 0 {
+			sizeChangeStyle = style.DiffOldLineStyle
+			sign = "-"
+			sizeChange = -sizeChange
+		}
+		content.WriteString(fmt.Sprintf("\n%s: %s\n", i18n.LANGUAGEMAPPING.FilePreviewSizeChange, sizeChangeStyle.Render(sign+formatByteSize(sizeChange))))
+	}
+	return content.String()
+}
+
+// the line shown above a paged diff
+func generateLargeFileDiffPageHeader(filePreview git.FilePreview, diffPage types.DetailPanelDiffPage) string {
+	pageHeader := fmt.Sprintf(i18n.LANGUAGEMAPPING.FilePreviewLargeFilePage, formatByteSize(max(filePreview.Old.Size, filePreview.New.Size)), git.LARGEFILEDIFFPAGELINES, diffPage.Page+1)
+	if !diffPage.HasNextPage {
+		pageHeader += " " + i18n.LANGUAGEMAPPING.FilePreviewLastPage
+	}
+	return style.NewStyle.Foreground(style.ColorYellowWarm).Render(pageHeader) + "\n\n"
+}
+
+func formatFilePreviewSize(blobPreview git.FileBlobPreview) string {
+	if !blobPreview.Exists {
+		return i18n.LANGUAGEMAPPING.FilePreviewNotExist
+	}
+	return formatByteSize(blobPreview.Size)
+}
+
+func formatFilePreviewMimeType(blobPreview git.FileBlobPreview) string {
+	if !blobPreview.Exists || blobPreview.MimeType == "" {
+		return "-"
+	}
+	return blobPreview.MimeType
+}
+
+func formatFilePreviewImageDimensions(blobPreview git.FileBlobPreview) string {
+	if blobPreview.ImageFormat == "" {
+		return "-"
+	}
+	return fmt.Sprintf("%d x %d (%s)", blobPreview.ImageWidth, blobPreview.ImageHeight, blobPreview.ImageFormat)
+}
+
+// size in a human readable unit, eg, 1.5 MiB
+func formatByteSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	divisor, exponent := int64(unit), 0
+	for quotient := size / unit; quotient >= unit && exponent < 4; quotient /= unit {
+		divisor *= unit
+		exponent++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(divisor), "KMGTP"[exponent])
+}
@@ -1,6 +1,9 @@
 package services
 
-import "github.com/gohyuhan/gitti/tui/types"
+import (
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/tui/types"
+)
 
 // ------------------------------------
 //
@@ -12,3 +15,14 @@ func GitResolveConflictService(m *types.GittiModel, filePathName string, resolve
 		m.GitOperations.GitFiles.GitResolveConflict(filePathName, resolveType)
 	}()
 }
+
+// ------------------------------------
+//
+//	For writing the resolution of the conflict editor back to the file
+//
+// ------------------------------------
+func GitWriteConflictResolutionService(m *types.GittiModel, filePathName string, conflictFile git.ConflictFile) {
+	go func() {
+		m.GitOperations.GitFiles.WriteConflictResolution(filePathName, conflictFile)
+	}()
+}
@@ -0,0 +1,16 @@
+package services
+
+import (
+	"github.com/gohyuhan/gitti/tui/types"
+)
+
+// ------------------------------------
+//
+//	For restoring a file as of a branch, tag or commit
+//
+// ------------------------------------
+func GitRestoreFileService(m *types.GittiModel, filePathName string, revision string, restoreTarget string) {
+	go func() {
+		m.GitOperations.GitFiles.GitRestoreFile(filePathName, revision, restoreTarget)
+	}()
+}
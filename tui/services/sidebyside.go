@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gohyuhan/gitti/api/git"
+	"github.com/gohyuhan/gitti/i18n"
+	"github.com/gohyuhan/gitti/tui/component/files"
+	"github.com/gohyuhan/gitti/tui/style"
+	"github.com/gohyuhan/gitti/tui/types"
+)
+
+// for modified file detail panel side by side view
+// when the file have both staged and unstaged changes, both diff will be shown one after another on the same side by side panels
+func generateModifiedFileSideBySideDetailPanelContent(ctx context.Context, m *types.GittiModel) (string, string, bool) {
+	currentSelectedModifiedFile := m.CurrentRepoModifiedFilesInfoList.SelectedItem()
+	var fileStatus git.FileStatus
+	if selectedFile, ok := currentSelectedModifiedFile.(files.GitModifiedFilesItem); ok {
+		fileStatus = git.FileStatus(selectedFile)
+	} else {
+		return "", "", false
+	}
+
+	if !fileStatus.HasConflict && fileStatus.IndexState != " " && fileStatus.WorkTree != " " {
+		stagedTitle := generateDiffOptionsHeader(m, false) + fmt.Sprintf("[ %s ]\n\n%s\n\n[ %s ]\n\n", fileStatus.DisplayPathname(), i18n.LANGUAGEMAPPING.StagedTitle, fileStatus.DisplayPathname())
+		stagedOldContent, stagedNewContent, stagedHasHunk := renderSideBySideDiffContent(stagedTitle, highlightDiffLines(m.GitOperations.GitFiles.GetFilesDiffInfo(ctx, fileStatus, git.GETSTAGEDDIFF, m.DiffOptions)))
+
+		unstagedTitle := fmt.Sprintf("\n%s\n\n[ %s ]\n\n", i18n.LANGUAGEMAPPING.UnstagedTitle, fileStatus.DisplayPathname())
+		unstagedOldContent, unstagedNewContent, unstagedHasHunk := renderSideBySideDiffContent(unstagedTitle, highlightDiffLines(m.GitOperations.GitFiles.GetFilesDiffInfo(ctx, fileStatus, git.GETUNSTAGEDDIFF, m.DiffOptions)))
+
+		if !stagedHasHunk || !unstagedHasHunk {
+			return "", "", false
+		}
+		return stagedOldContent + unstagedOldContent, stagedNewContent + unstagedNewContent, true
+	}
+
+	title := generateDiffOptionsHeader(m, false) + fmt.Sprintf("[ %s ]\n\n", fileStatus.DisplayPathname())
+	return renderSideBySideDiffContent(title, highlightDiffLines(m.GitOperations.GitFiles.GetFilesDiffInfo(ctx, fileStatus, git.GETCOMBINEDDIFF, m.DiffOptions)))
+}
+
+// render the diff lines into the content for the old (left) and new (right) detail component panel,
+// both content will always have the same number of lines so that they stay aligned when scrolled together
+// * return false if there is no hunk to be shown side by side
+func renderSideBySideDiffContent(title string, diffLines []string) (string, string, bool) {
+	rows, hasHunk := git.SplitDiffLinesSideBySide(diffLines)
+	if !hasHunk {
+		return "", "", false
+	}
+
+	largestLineNumber := 0
+	for _, row := range rows {
+		largestLineNumber = max(largestLineNumber, row.OldLineNumber, row.NewLineNumber)
+	}
+	lineNumberWidth := len(strconv.Itoa(largestLineNumber))
+
+	var oldContent strings.Builder
+	var newContent strings.Builder
+	oldContent.WriteString(title)
+	newContent.WriteString(title)
+	for _, row := range rows {
+		oldContent.WriteString(renderSideBySideDiffLine(row.OldLine, row.OldLineNumber, lineNumberWidth))
+		newContent.WriteString(renderSideBySideDiffLine(row.NewLine, row.NewLineNumber, lineNumberWidth))
+	}
+	return oldContent.String(), newContent.String(), true
+}
+
+func renderSideBySideDiffLine(line string, lineNumber int, lineNumberWidth int) string {
+	gutter := strings.Repeat(" ", lineNumberWidth)
+	if lineNumber > 0 {
+		gutter = fmt.Sprintf("%*d", lineNumberWidth, lineNumber)
+	}
+	return style.DiffLineNumberStyle.Render(gutter) + " " + style.NewStyle.Render(line) + "\n"
+}
@@ -34,7 +34,11 @@ func GitStashOperationService(m *types.GittiModel, filePathName string, stashId
 		case git.STASHALL:
 			resultOutput, exitStatusCode = m.GitOperations.GitStash.GitStashAll(stashMessage)
 		case git.STASHFILE:
-			resultOutput, exitStatusCode = m.GitOperations.GitStash.GitStashFile(filePathName, stashMessage)
+			fileStatus, fileExist := m.GitOperations.GitFiles.FileStatusOf(filePathName)
+			if !fileExist {
+				fileStatus = git.FileStatus{FilePathname: filePathName}
+			}
+			resultOutput, exitStatusCode = m.GitOperations.GitStash.GitStashFile(fileStatus, stashMessage)
 		case git.APPLYSTASH:
 			resultOutput, exitStatusCode = m.GitOperations.GitStash.GitStashApply(stashId)
 		case git.POPSTASH:
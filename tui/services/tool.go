@@ -0,0 +1,72 @@
+package services
+
+import (
+	"os/exec"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/gohyuhan/gitti/api/git"
+	filesComponent "github.com/gohyuhan/gitti/tui/component/files"
+	"github.com/gohyuhan/gitti/tui/constant"
+	resolvePopUp "github.com/gohyuhan/gitti/tui/popup/resolve"
+	"github.com/gohyuhan/gitti/tui/types"
+)
+
+// ------------------------------------
+//
+//	For launching the merge tool configured in git config on a conflicted file
+//	* gitti will be suspended until the merge tool quit
+//
+// ------------------------------------
+func LaunchGitMergeToolService(m *types.GittiModel, filePathName string) tea.Cmd {
+	return launchGitToolService(m, m.GitOperations.GitFiles.GitMergeToolCmd(filePathName), filePathName, true)
+}
+
+// ------------------------------------
+//
+//	For launching the diff tool configured in git config on a modified file
+//
+// ------------------------------------
+func LaunchGitDiffToolService(m *types.GittiModel, fileStatus git.FileStatus) tea.Cmd {
+	cmd := m.GitOperations.GitFiles.GitDiffToolCmd(fileStatus)
+	if cmd == nil {
+		return nil
+	}
+	return launchGitToolService(m, cmd, fileStatus.FilePathname, false)
+}
+
+// the files status is refreshed before the message is sent back, so the result of the merge tool can be reported right away
+func launchGitToolService(m *types.GittiModel, cmd *exec.Cmd, filePathName string, isMergeTool bool) tea.Cmd {
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		m.GitOperations.GitFiles.GetGitFilesStatus()
+		finishedMsg := types.GitToolFinishedMsg{
+			FilePathName: filePathName,
+			IsMergeTool:  isMergeTool,
+			Err:          err,
+		}
+		if isMergeTool {
+			fileStatus, exist := m.GitOperations.GitFiles.FileStatusOf(filePathName)
+			finishedMsg.IsResolved = !exist || !fileStatus.HasConflict
+		}
+		return finishedMsg
+	})
+}
+
+// ------------------------------------
+//
+//	For refreshing the modified files once the merge tool or diff tool quit,
+//	the result will be reported for merge tool
+//
+// ------------------------------------
+func GitToolFinishedService(m *types.GittiModel, msg types.GitToolFinishedMsg) {
+	needReinit := filesComponent.InitModifiedFilesList(m)
+	if m.CurrentSelectedComponent == constant.ModifiedFilesComponent || m.DetailPanelParentComponent == constant.ModifiedFilesComponent {
+		FetchDetailComponentPanelInfoService(m, needReinit)
+	}
+
+	if msg.IsMergeTool {
+		m.PopUpType = constant.GitMergeToolResultPopUp
+		resolvePopUp.InitGitMergeToolResultPopUpModel(m, msg.FilePathName, msg.IsResolved, msg.Err)
+		m.ShowPopUp.Store(true)
+		m.IsTyping.Store(false)
+	}
+}
@@ -58,11 +58,17 @@ func FetchDetailComponentPanelInfoService(m *types.GittiModel, reinit bool) {
 		var contentLine string
 		var contentLine2 string // fro detail panel 2nd (only used for files changes to show staged and unstaged diff in seperated panel)
 		setForDetailComponentTwo := false
+		isSideBySide := false // the old content will be on detail panel one and the new content on detail panel two
+		var diffCursor *types.DetailPanelDiffCursor
+		var diffCursorTwo *types.DetailPanelDiffCursor
+		var diffPage types.DetailPanelDiffPage
+		var blameCursor *types.DetailPanelBlameCursor
 		var theCurrentSelectedComponent string
 		// reinit and render detail component panel viewport
 		if reinit {
 			m.DetailPanelViewport.SetContent(style.NewStyle.Render(i18n.LANGUAGEMAPPING.Loading))
 			m.ShowDetailPanelTwo.Store(false)
+			m.IsDetailPanelSideBySide.Store(false)
 			m.DetailPanelViewportOffset = 0
 			m.DetailPanelViewport.SetXOffset(0)
 			m.DetailPanelViewport.SetYOffset(0)
@@ -70,7 +76,7 @@ func FetchDetailComponentPanelInfoService(m *types.GittiModel, reinit bool) {
 			m.DetailPanelTwoViewport.SetXOffset(0)
 			m.DetailPanelTwoViewport.SetYOffset(0)
 		}
-		if m.CurrentSelectedComponent == constant.DetailComponent {
+		if m.CurrentSelectedComponent == constant.DetailComponent || m.CurrentSelectedComponent == constant.DetailComponentTwo {
 			// if the current selected one is the detail component itself, the current selected one will be its parent (the component that led into the detail component)
 			theCurrentSelectedComponent = m.DetailPanelParentComponent
 		} else {
@@ -78,11 +84,49 @@ func FetchDetailComponentPanelInfoService(m *types.GittiModel, reinit bool) {
 		}
 		switch theCurrentSelectedComponent {
 		case constant.ModifiedFilesComponent:
-			contentLine, contentLine2, setForDetailComponentTwo = generateBothModifiedFileDetailPanelContent(ctx, m)
+			if _, isDirectory := m.CurrentRepoModifiedFilesInfoList.SelectedItem().(files.GitModifiedFilesDirectoryItem); isDirectory {
+				contentLine = generateModifiedDirectoryDetailPanelContent(m)
+				break
+			}
+			if selectedFile, ok := m.CurrentRepoModifiedFilesInfoList.SelectedItem().(files.GitModifiedFilesItem); ok && selectedFile.IndexState == "!" {
+				contentLine = generateIgnoredFileDetailPanelContent(ctx, m, selectedFile.FilePathname)
+				break
+			}
+			if m.IsBlameView {
+				blameCursor = generateModifiedFileBlameDetailPanelCursor(ctx, m)
+				if blameCursor != nil {
+					contentLine = renderDetailPanelBlameCursorContent(blameCursor, m.CurrentSelectedComponent == constant.DetailComponent)
+					break
+				}
+			}
+			fileStatus, filePreview, ok := fetchModifiedFilePreview(ctx, m)
+			if ok && filePreview.IsBinary() {
+				contentLine = generateModifiedFileBinaryPreviewDetailPanelContent(fileStatus, filePreview)
+				break
+			}
+			// a large file will always be shown as a paged unified diff
+			if m.IsSideBySideDiffView && !filePreview.IsLarge() {
+				contentLine, contentLine2, isSideBySide = generateModifiedFileSideBySideDetailPanelContent(ctx, m)
+				if isSideBySide {
+					break
+				}
+			}
+			diffCursor, diffCursorTwo, diffPage = generateBothModifiedFileDetailPanelDiffCursor(ctx, m, filePreview)
+			if diffCursor != nil {
+				contentLine = renderDetailPanelDiffCursorContent(diffCursor, m.CurrentSelectedComponent == constant.DetailComponent)
+			}
+			if diffCursorTwo != nil {
+				contentLine2 = renderDetailPanelDiffCursorContent(diffCursorTwo, m.CurrentSelectedComponent == constant.DetailComponentTwo)
+				setForDetailComponentTwo = true
+			}
 		case constant.CommitLogComponent:
-			contentLine = generateCommitLogDetailPanelContent(ctx, m)
+			if m.IsCommitLogFilesView {
+				contentLine, contentLine2, isSideBySide = generateCommitLogFileDetailPanelContent(ctx, m)
+				break
+			}
+			contentLine, contentLine2, isSideBySide = generateCommitLogDetailPanelContent(ctx, m)
 		case constant.StashComponent:
-			contentLine = generateStashDetailPanelContent(ctx, m)
+			contentLine, contentLine2, isSideBySide = generateStashDetailPanelContent(ctx, m)
 		default:
 			contentLine = generateAboutGittiContent()
 		}
@@ -96,10 +140,18 @@ func FetchDetailComponentPanelInfoService(m *types.GittiModel, reinit bool) {
 				contentLine = generateAboutGittiContent()
 			}
 			m.DetailPanelViewport.SetContent(contentLine)
+			m.DetailPanelDiffCursor = diffCursor
+			m.DetailPanelTwoDiffCursor = diffCursorTwo
+			m.DetailPanelDiffPage = diffPage
+			m.DetailPanelBlameCursor = blameCursor
+			m.IsDetailPanelSideBySide.Store(isSideBySide)
 
-			if setForDetailComponentTwo {
+			if setForDetailComponentTwo || isSideBySide {
 				m.DetailPanelTwoViewport.SetContent(contentLine2)
 				m.ShowDetailPanelTwo.Store(true)
+			} else {
+				// the file might no longer have both staged and unstaged changes (eg, after a hunk was staged)
+				m.ShowDetailPanelTwo.Store(false)
 			}
 
 			m.TuiUpdateChannel <- constant.DETAIL_COMPONENT_PANEL_UPDATED
@@ -109,106 +161,238 @@ func FetchDetailComponentPanelInfoService(m *types.GittiModel, reinit bool) {
 }
 
 // for modified file detail panel view
-func generateBothModifiedFileDetailPanelContent(ctx context.Context, m *types.GittiModel) (string, string, bool) {
-	shouldRenderDetailComponentPanelTwo := false
+// the diff will be returned as a cursor so that the hunks and lines within it can be navigated and staged or unstaged individually
+// the diff of a large file will be streamed page by page, a page can't be staged or discarded from as it might start or end in the middle of a hunk
+func generateBothModifiedFileDetailPanelDiffCursor(ctx context.Context, m *types.GittiModel, filePreview git.FilePreview) (*types.DetailPanelDiffCursor, *types.DetailPanelDiffCursor, types.DetailPanelDiffPage) {
 	currentSelectedModifiedFile := m.CurrentRepoModifiedFilesInfoList.SelectedItem()
 	var fileStatus git.FileStatus
-	if currentSelectedModifiedFile != nil {
-		fileStatus = git.FileStatus(currentSelectedModifiedFile.(files.GitModifiedFilesItem))
+	if selectedFile, ok := currentSelectedModifiedFile.(files.GitModifiedFilesItem); ok {
+		fileStatus = git.FileStatus(selectedFile)
 	} else {
-		return "", "", shouldRenderDetailComponentPanelTwo
+		return nil, nil, types.DetailPanelDiffPage{}
 	}
 
-	var vpLine1 strings.Builder
-	vpLine1.WriteString(fmt.Sprintf("[ %s ]\n\n", fileStatus.FilePathname))
-
-	var vpLine2 strings.Builder
-	var fileDiffLines1 []string
-	var fileDiffLines2 []string
+	diffPage := types.DetailPanelDiffPage{
+		FilePathName: fileStatus.FilePathname,
+		IsPaged:      filePreview.IsLarge(),
+	}
+	if diffPage.IsPaged && m.DetailPanelDiffPage.FilePathName == fileStatus.FilePathname {
+		diffPage.Page = m.DetailPanelDiffPage.Page
+	}
+	hasBothChanges := !fileStatus.HasConflict && fileStatus.IndexState != " " && fileStatus.WorkTree != " "
 	getDiffTypeForVpLine1 := git.GETCOMBINEDDIFF
+	if hasBothChanges {
+		getDiffTypeForVpLine1 = git.GETSTAGEDDIFF
+	}
 
-	// indicating that the file is not in conflict state and have both staged and unstaged changes
-	if !fileStatus.HasConflict && fileStatus.IndexState != " " && fileStatus.WorkTree != " " {
-		shouldRenderDetailComponentPanelTwo = true
-		vpLine2.WriteString(fmt.Sprintf("%s\n\n[ %s ]\n\n", i18n.LANGUAGEMAPPING.UnstagedTitle, fileStatus.FilePathname))
-		fileDiffLines2 = m.GitOperations.GitFiles.GetFilesDiffInfo(ctx, fileStatus, git.GETUNSTAGEDDIFF)
-
-		if fileDiffLines2 == nil {
-			vpLine2.WriteString(i18n.LANGUAGEMAPPING.FileTypeUnSupportedPreview)
-		} else {
-			for _, line := range fileDiffLines2 {
-				line = style.NewStyle.Render(line)
-				vpLine2.WriteString(line + "\n")
+	var fileDiffLines1, fileDiffLines2 []string
+	if diffPage.IsPaged {
+		for {
+			var hasNextPage1, hasNextPage2 bool
+			fileDiffLines1, hasNextPage1 = m.GitOperations.GitFiles.GetFilesDiffInfoPage(ctx, fileStatus, getDiffTypeForVpLine1, m.DiffOptions, diffPage.Page)
+			if hasBothChanges {
+				fileDiffLines2, hasNextPage2 = m.GitOperations.GitFiles.GetFilesDiffInfoPage(ctx, fileStatus, git.GETUNSTAGEDDIFF, m.DiffOptions, diffPage.Page)
 			}
+			diffPage.HasNextPage = hasNextPage1 || hasNextPage2
+			// the file might have become shorter than the page that was last shown, start over from the first page
+			if diffPage.Page == 0 || len(fileDiffLines1) > 0 || len(fileDiffLines2) > 0 || ctx.Err() != nil {
+				break
+			}
+			diffPage.Page = 0
+		}
+	} else {
+		fileDiffLines1 = m.GitOperations.GitFiles.GetFilesDiffInfo(ctx, fileStatus, getDiffTypeForVpLine1, m.DiffOptions)
+		if hasBothChanges {
+			fileDiffLines2 = m.GitOperations.GitFiles.GetFilesDiffInfo(ctx, fileStatus, git.GETUNSTAGEDDIFF, m.DiffOptions)
 		}
+	}
 
-		getDiffTypeForVpLine1 = git.GETSTAGEDDIFF
-		vpLine1.WriteString(fmt.Sprintf("%s\n\n[ %s ]\n\n", i18n.LANGUAGEMAPPING.StagedTitle, fileStatus.FilePathname))
+	var diffCursorTwo *types.DetailPanelDiffCursor
+	isReadOnly := !m.DiffOptions.IsPatchable() || diffPage.IsPaged
+	header := generateDiffOptionsHeader(m, true)
+	if diffPage.IsPaged {
+		header += generateLargeFileDiffPageHeader(filePreview, diffPage)
 	}
+	title := header + fmt.Sprintf("[ %s ]\n\n", fileStatus.DisplayPathname())
 
-	fileDiffLines1 = m.GitOperations.GitFiles.GetFilesDiffInfo(ctx, fileStatus, getDiffTypeForVpLine1)
-	if fileDiffLines1 == nil {
-		vpLine1.WriteString(i18n.LANGUAGEMAPPING.FileTypeUnSupportedPreview)
-	} else {
-		for _, line := range fileDiffLines1 {
-			line = style.NewStyle.Render(line)
-			vpLine1.WriteString(line + "\n")
+	// indicating that the file is not in conflict state and have both staged and unstaged changes
+	if hasBothChanges {
+		titleTwo := header + fmt.Sprintf("%s\n\n[ %s ]\n\n", i18n.LANGUAGEMAPPING.UnstagedTitle, fileStatus.DisplayPathname())
+		diffCursorTwo = newDetailPanelDiffCursor(m.DetailPanelTwoDiffCursor, fileStatus, git.GETUNSTAGEDDIFF, titleTwo, fileDiffLines2, isReadOnly)
+		title += fmt.Sprintf("%s\n\n[ %s ]\n\n", i18n.LANGUAGEMAPPING.StagedTitle, fileStatus.DisplayPathname())
+	}
+	diffCursor := newDetailPanelDiffCursor(m.DetailPanelDiffCursor, fileStatus, getDiffTypeForVpLine1, title, fileDiffLines1, isReadOnly)
+
+	return diffCursor, diffCursorTwo, diffPage
+}
+
+// for modified directory detail panel view (tree view), list every changed file under the directory
+func generateModifiedDirectoryDetailPanelContent(m *types.GittiModel) string {
+	currentSelectedDirectory, ok := m.CurrentRepoModifiedFilesInfoList.SelectedItem().(files.GitModifiedFilesDirectoryItem)
+	if !ok {
+		return ""
+	}
+
+	var vpLine strings.Builder
+	vpLine.WriteString(fmt.Sprintf("[ %s/ ]\n\n", currentSelectedDirectory.DirectoryPath))
+	directoryPrefix := currentSelectedDirectory.DirectoryPath + "/"
+	for _, file := range m.GitOperations.GitFiles.FilesStatus() {
+		if !strings.HasPrefix(file.FilePathname, directoryPrefix) {
+			continue
+		}
+		indexState := style.StagedFileStyle.Render(file.IndexState)
+		workTree := style.UnstagedFileStyle.Render(file.WorkTree)
+		if file.IndexState == "?" {
+			indexState = style.UnstagedFileStyle.Render(file.IndexState)
+		} else if file.IndexState == "!" {
+			indexState = style.IgnoredFileStyle.Render(file.IndexState)
+			workTree = style.IgnoredFileStyle.Render(file.WorkTree)
 		}
+		vpLine.WriteString(fmt.Sprintf("%s%s %s\n", indexState, workTree, file.DisplayPathname()))
 	}
+	return vpLine.String()
+}
+
+// for ignored file detail panel view, show the ignore rule that the file matched
+func generateIgnoredFileDetailPanelContent(ctx context.Context, m *types.GittiModel, filePathName string) string {
+	var vpLine strings.Builder
+	vpLine.WriteString(fmt.Sprintf("[ %s ]\n\n", filePathName))
 
-	return vpLine1.String(), vpLine2.String(), shouldRenderDetailComponentPanelTwo
+	ignoreMatch, isIgnored := m.GitOperations.GitFiles.GitCheckIgnore(ctx, filePathName)
+	if !isIgnored {
+		vpLine.WriteString(style.IgnoredFileStyle.Render(i18n.LANGUAGEMAPPING.IgnoredFileNoMatchingRule))
+		return vpLine.String()
+	}
+	vpLine.WriteString(i18n.LANGUAGEMAPPING.IgnoredFileMatchingRule + "\n\n")
+	vpLine.WriteString(fmt.Sprintf("  %s\n", style.StashIdStyle.Render(ignoreMatch.Pattern)))
+	vpLine.WriteString(fmt.Sprintf("  %s\n", style.StashFilePathStyle.Render(fmt.Sprintf(i18n.LANGUAGEMAPPING.IgnoredFileRuleSource, ignoreMatch.Source, ignoreMatch.LineNumber))))
+	return vpLine.String()
 }
 
 // for commit log detail panel view
-func generateCommitLogDetailPanelContent(ctx context.Context, m *types.GittiModel) string {
+// the 2nd and 3rd return value will only be set when it can be shown side by side
+func generateCommitLogDetailPanelContent(ctx context.Context, m *types.GittiModel) (string, string, bool) {
 	currentSelectedCommitLog := m.CurrentRepoCommitLogInfoList.SelectedItem()
 	var commitLogItem commitlog.GitCommitLogItem
 	var vpLine strings.Builder
 	if currentSelectedCommitLog != nil {
 		commitLogItem = currentSelectedCommitLog.(commitlog.GitCommitLogItem)
 	} else {
-		return ""
+		return "", "", false
 	}
 
-	commitLogDetail := m.GitOperations.GitCommitLog.GitCommitLogDetail(ctx, commitLogItem.Hash)
+	commitLogDetail := m.GitOperations.GitCommitLog.GitCommitLogDetail(ctx, commitLogItem.Hash, m.DiffOptions)
 	if len(commitLogDetail) < 1 {
-		return ""
+		return "", "", false
 	}
+	commitLogDetail = highlightDiffLines(commitLogDetail)
+
+	diffOptionsHeader := generateDiffOptionsHeader(m, false)
+	if m.IsSideBySideDiffView {
+		if oldContent, newContent, hasHunk := renderSideBySideDiffContent(diffOptionsHeader, commitLogDetail); hasHunk {
+			return oldContent, newContent, true
+		}
+	}
+	vpLine.WriteString(diffOptionsHeader)
 
 	for _, Line := range commitLogDetail {
 		line := style.NewStyle.Render(Line)
 		vpLine.WriteString(line + "\n")
 	}
-	return vpLine.String()
+	return vpLine.String(), "", false
+}
+
+// for the diff of the selected changed file of a commit
+// the 2nd and 3rd return value will only be set when it can be shown side by side
+func generateCommitLogFileDetailPanelContent(ctx context.Context, m *types.GittiModel) (string, string, bool) {
+	commitLogFileItem, ok := m.CurrentRepoCommitLogFilesInfoList.SelectedItem().(commitlog.GitCommitLogFileItem)
+	if !ok {
+		return "", "", false
+	}
+
+	filesView := m.CommitLogFilesView
+	commitFileDiff := m.GitOperations.GitCommitLog.CommitFileDiff(ctx, filesView.CommitHash, filesView.ParentHash, commitLogFileItem.CommitFile, m.DiffOptions)
+	if len(commitFileDiff) < 1 {
+		return "", "", false
+	}
+	commitFileDiff = highlightDiffLines(commitFileDiff)
+
+	diffOptionsHeader := generateDiffOptionsHeader(m, false)
+	if m.IsSideBySideDiffView {
+		if oldContent, newContent, hasHunk := renderSideBySideDiffContent(diffOptionsHeader, commitFileDiff); hasHunk {
+			return oldContent, newContent, true
+		}
+	}
+
+	var vpLine strings.Builder
+	vpLine.WriteString(diffOptionsHeader)
+	for _, Line := range commitFileDiff {
+		vpLine.WriteString(style.NewStyle.Render(Line) + "\n")
+	}
+	return vpLine.String(), "", false
 }
 
 // for stash detail panel view
-func generateStashDetailPanelContent(ctx context.Context, m *types.GittiModel) string {
+// the 2nd and 3rd return value will only be set when it can be shown side by side
+func generateStashDetailPanelContent(ctx context.Context, m *types.GittiModel) (string, string, bool) {
 	currentSelectedStash := m.CurrentRepoStashInfoList.SelectedItem()
 	var stashItem stash.GitStashItem
 	if currentSelectedStash != nil {
 		stashItem = currentSelectedStash.(stash.GitStashItem)
 	} else {
-		return ""
+		return "", "", false
 	}
 
-	var vpLine strings.Builder
-	vpLine.WriteString(fmt.Sprintf(
+	title := generateDiffOptionsHeader(m, false) + fmt.Sprintf(
 		"[%s]\n[%s]\n\n",
 		style.StashIdStyle.Render(stashItem.Id),
 		style.StashMessageStyle.Render(stashItem.Message),
-	))
+	)
+	var vpLine strings.Builder
+	vpLine.WriteString(title)
 
-	stashDetail := m.GitOperations.GitStash.GitStashDetail(ctx, stashItem.Id)
+	stashDetail := m.GitOperations.GitStash.GitStashDetail(ctx, stashItem.Id, m.DiffOptions)
 	if len(stashDetail) < 1 {
-		return ""
+		return "", "", false
+	}
+	stashDetail = highlightDiffLines(stashDetail)
+
+	if m.IsSideBySideDiffView {
+		if oldContent, newContent, hasHunk := renderSideBySideDiffContent(title, stashDetail); hasHunk {
+			return oldContent, newContent, true
+		}
 	}
 
 	for _, Line := range stashDetail {
 		line := style.NewStyle.Render(Line)
 		vpLine.WriteString(line + "\n")
 	}
-	return vpLine.String()
+	return vpLine.String(), "", false
+}
+
+// the current diff options to be shown above the diff
+func generateDiffOptionsHeader(m *types.GittiModel, isFileDiff bool) string {
+	diffOptionsInfo := []string{
+		fmt.Sprintf(i18n.LANGUAGEMAPPING.DiffOptionsContextLines, m.DiffOptions.ContextLines),
+		fmt.Sprintf(i18n.LANGUAGEMAPPING.DiffOptionsAlgorithm, m.DiffOptions.Algorithm),
+	}
+	if m.DiffOptions.DetectRenames {
+		diffOptionsInfo = append(diffOptionsInfo, i18n.LANGUAGEMAPPING.DiffOptionsRenamesOn)
+	} else {
+		diffOptionsInfo = append(diffOptionsInfo, i18n.LANGUAGEMAPPING.DiffOptionsRenamesOff)
+	}
+	switch m.DiffOptions.Whitespace {
+	case git.DIFFIGNORESPACECHANGE:
+		diffOptionsInfo = append(diffOptionsInfo, i18n.LANGUAGEMAPPING.DiffOptionsIgnoreSpaceChange)
+	case git.DIFFIGNOREALLSPACE:
+		diffOptionsInfo = append(diffOptionsInfo, i18n.LANGUAGEMAPPING.DiffOptionsIgnoreAllSpace)
+	}
+
+	header := style.DiffLineNumberStyle.Render(strings.Join(diffOptionsInfo, " · ")) + "\n"
+	if isFileDiff && !m.DiffOptions.IsPatchable() {
+		header += style.DiffLineNumberStyle.Render(i18n.LANGUAGEMAPPING.DiffOptionsReadOnly) + "\n"
+	}
+	return header + "\n"
 }
 
 // for about gitti content
@@ -21,6 +21,10 @@ var (
 	ColorPurpleSoft    = lipgloss.Color("#B496FF") // Beautiful lavender purple (from your reference)
 	ColorPurpleVibrant = lipgloss.Color("#9F7AEA") // Rich purple for titles
 	ColorCyanSoft      = lipgloss.Color("#7DD3FC") // Sky blue for key bindings
+	ColorRedDeep       = lipgloss.Color("#5C2326") // Deep red background for removed words
+	ColorGreenDeep     = lipgloss.Color("#1F4A2A") // Deep green background for added words
+	ColorRedSubtle     = lipgloss.Color("#2E1719") // Subtle red background for removed lines
+	ColorGreenSubtle   = lipgloss.Color("#15291B") // Subtle green background for added lines
 
 	// lipgloss empty new style
 	NewStyle = lipgloss.NewStyle()
@@ -72,23 +76,64 @@ var (
 				Foreground(ColorError)
 	DiffNewLineStyle = NewStyle.
 				Foreground(ColorGreenSoft)
+	DiffOldWordStyle = DiffOldLineStyle.
+				Background(ColorRedDeep).
+				Bold(true)
+	DiffNewWordStyle = DiffNewLineStyle.
+				Background(ColorGreenDeep).
+				Bold(true)
+	MarkedItemStyle = NewStyle.Foreground(ColorYellowWarm)
+
+	DiffCursorStyle = NewStyle.
+			Foreground(ColorYellowWarm)
+	DiffLineNumberStyle = NewStyle.
+				Foreground(ColorBlueGrayMuted)
+
+	ConflictMarkerStyle = NewStyle.
+				Foreground(ColorYellowWarm)
+	ConflictOursStyle = NewStyle.
+				Foreground(ColorGreenSoft)
+	ConflictTheirsStyle = NewStyle.
+				Foreground(ColorBlueSoft)
+	ConflictBaseStyle = NewStyle.
+				Foreground(ColorBlueGrayMuted)
 
 	StagedFileStyle = NewStyle.
 			Foreground(ColorGreenSoft)
 	UnstagedFileStyle = NewStyle.
 				Foreground(ColorError)
+	IgnoredFileStyle = NewStyle.
+				Foreground(ColorBlueGrayMuted).
+				Faint(true)
 
 	LocalStatusStyle = NewStyle.
 				Foreground(ColorGreenSoft)
 	RemoteStatusStyle = NewStyle.
 				Foreground(ColorError)
 
+	CommitRefHeadStyle = NewStyle.
+				Foreground(ColorCyanSoft).
+				Bold(true)
+	CommitRefLocalBranchStyle = NewStyle.
+					Foreground(ColorGreenSoft).
+					Bold(true)
+	CommitRefRemoteBranchStyle = NewStyle.
+					Foreground(ColorError).
+					Bold(true)
+	CommitRefTagStyle = NewStyle.
+				Foreground(ColorYellowWarm).
+				Bold(true)
+
 	StashIdStyle       = NewStyle.Foreground(ColorYellowWarm)
 	StashMessageStyle  = NewStyle.Foreground(ColorYellowSoft)
 	StashFilePathStyle = NewStyle.Foreground(ColorCyanSoft)
 
 	ErrorStyle = NewStyle.
 			Foreground(ColorError)
+
+	RepoOperationStateStyle = NewStyle.
+				Foreground(ColorYellowWarm).
+				Bold(true)
 )
 
 var Palette = []color.Color{
@@ -0,0 +1,391 @@
+package style
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"charm.land/lipgloss/v2"
+)
+
+// the kind of a syntax token, it decide the color of the token
+const (
+	SYNTAXPLAIN    = "SYNTAXPLAIN"
+	SYNTAXKEYWORD  = "SYNTAXKEYWORD"
+	SYNTAXTYPE     = "SYNTAXTYPE"
+	SYNTAXLITERAL  = "SYNTAXLITERAL" // true, false, nil, null ...
+	SYNTAXSTRING   = "SYNTAXSTRING"
+	SYNTAXNUMBER   = "SYNTAXNUMBER"
+	SYNTAXCOMMENT  = "SYNTAXCOMMENT"
+	SYNTAXFUNCTION = "SYNTAXFUNCTION" // a word that is followed by "("
+	SYNTAXKEY      = "SYNTAXKEY"      // the key of a YAML mapping or JSON object
+	SYNTAXVARIABLE = "SYNTAXVARIABLE" // shell $VAR
+	SYNTAXHEADING  = "SYNTAXHEADING"  // markdown heading
+)
+
+var (
+	ColorOrangeSoft = lipgloss.Color("#F78C6C") // Soft orange for numbers and literals
+
+	SyntaxPlainStyle    = NewStyle.Foreground(ColorBlueVeryLight)
+	SyntaxKeywordStyle  = NewStyle.Foreground(ColorPurpleSoft)
+	SyntaxTypeStyle     = NewStyle.Foreground(ColorYellowWarm)
+	SyntaxLiteralStyle  = NewStyle.Foreground(ColorOrangeSoft)
+	SyntaxStringStyle   = NewStyle.Foreground(ColorYellowSoft)
+	SyntaxNumberStyle   = NewStyle.Foreground(ColorOrangeSoft)
+	SyntaxCommentStyle  = NewStyle.Foreground(ColorBlueGrayMuted).Italic(true)
+	SyntaxFunctionStyle = NewStyle.Foreground(ColorBlueSoft)
+	SyntaxKeyStyle      = NewStyle.Foreground(ColorCyanSoft)
+	SyntaxVariableStyle = NewStyle.Foreground(ColorCyanSoft)
+	SyntaxHeadingStyle  = NewStyle.Foreground(ColorPurpleVibrant).Bold(true)
+)
+
+type SyntaxToken struct {
+	Text string
+	Kind string
+}
+
+// the rules for tokenizing a language, the tokenizer work on a single line without knowing the lines before it
+type SyntaxLanguage struct {
+	Name                string
+	Keywords            map[string]bool
+	Types               map[string]bool
+	Literals            map[string]bool
+	LineCommentPrefixes []string
+	BlockCommentStart   string
+	BlockCommentEnd     string
+	StringDelimiters    string
+	WordExtraRunes      string // runes other than letters, digits and underscore that can be part of a word
+	HasVariables        bool   // shell $VAR and ${VAR}
+	HasKeys             bool   // YAML `key:` and JSON `"key":`
+	IsMarkdown          bool
+}
+
+var yamlKeyRegex = regexp.MustCompile(`^(\s*(?:-\s+)?)([^\s#'"{}\[\],&*!|>%@` + "`" + `][^#:]*?|"[^"]*"|'[^']*')(\s*:)(\s|$)`)
+var markdownHeadingRegex = regexp.MustCompile(`^\s{0,3}#{1,6}(\s|$)`)
+var markdownListMarkerRegex = regexp.MustCompile(`^(\s*)([-*+>]|\d+[.)])(\s)`)
+
+func wordSet(words string) map[string]bool {
+	set := make(map[string]bool)
+	for _, word := range strings.Fields(words) {
+		set[word] = true
+	}
+	return set
+}
+
+var (
+	goSyntaxLanguage = &SyntaxLanguage{
+		Name:                "go",
+		Keywords:            wordSet("break case chan const continue default defer else fallthrough for func go goto if import interface map package range return select struct switch type var"),
+		Types:               wordSet("any bool byte complex64 complex128 error float32 float64 int int8 int16 int32 int64 rune string uint uint8 uint16 uint32 uint64 uintptr comparable"),
+		Literals:            wordSet("true false nil iota"),
+		LineCommentPrefixes: []string{"//"},
+		BlockCommentStart:   "/*",
+		BlockCommentEnd:     "*/",
+		StringDelimiters:    "\"'`",
+	}
+	javascriptSyntaxLanguage = &SyntaxLanguage{
+		Name:                "javascript",
+		Keywords:            wordSet("abstract as async await break case catch class const continue debugger declare default delete do else enum export extends finally for from function get if implements import in instanceof interface keyof let namespace new of private protected public readonly return satisfies set static super switch this throw try type typeof var void while with yield"),
+		Types:               wordSet("any bigint boolean never number object string symbol unknown"),
+		Literals:            wordSet("true false null undefined NaN Infinity"),
+		LineCommentPrefixes: []string{"//"},
+		BlockCommentStart:   "/*",
+		BlockCommentEnd:     "*/",
+		StringDelimiters:    "\"'`",
+		WordExtraRunes:      "$",
+	}
+	pythonSyntaxLanguage = &SyntaxLanguage{
+		Name:                "python",
+		Keywords:            wordSet("and as assert async await break case class continue def del elif else except finally for from global if import in is lambda match nonlocal not or pass raise return try while with yield"),
+		Types:               wordSet("bool bytes dict float frozenset int list object set str tuple"),
+		Literals:            wordSet("True False None self cls"),
+		LineCommentPrefixes: []string{"#"},
+		StringDelimiters:    "\"'",
+	}
+	shellSyntaxLanguage = &SyntaxLanguage{
+		Name:                "shell",
+		Keywords:            wordSet("alias break case continue declare do done elif else esac exit export fi for function if in local readonly return select set shift source then trap unset until while"),
+		Types:               wordSet(""),
+		Literals:            wordSet("true false"),
+		LineCommentPrefixes: []string{"#"},
+		StringDelimiters:    "\"'`",
+		WordExtraRunes:      "-",
+		HasVariables:        true,
+	}
+	yamlSyntaxLanguage = &SyntaxLanguage{
+		Name:                "yaml",
+		Keywords:            wordSet(""),
+		Types:               wordSet(""),
+		Literals:            wordSet("true false null yes no on off True False Null Yes No On Off TRUE FALSE NULL ~"),
+		LineCommentPrefixes: []string{"#"},
+		StringDelimiters:    "\"'",
+		HasKeys:             true,
+	}
+	jsonSyntaxLanguage = &SyntaxLanguage{
+		Name:             "json",
+		Keywords:         wordSet(""),
+		Types:            wordSet(""),
+		Literals:         wordSet("true false null"),
+		StringDelimiters: "\"",
+		HasKeys:          true,
+	}
+	markdownSyntaxLanguage = &SyntaxLanguage{
+		Name:             "markdown",
+		Keywords:         wordSet(""),
+		Types:            wordSet(""),
+		Literals:         wordSet(""),
+		StringDelimiters: "`",
+		IsMarkdown:       true,
+	}
+)
+
+var syntaxLanguageByExtension = map[string]*SyntaxLanguage{
+	".go":       goSyntaxLanguage,
+	".js":       javascriptSyntaxLanguage,
+	".jsx":      javascriptSyntaxLanguage,
+	".mjs":      javascriptSyntaxLanguage,
+	".cjs":      javascriptSyntaxLanguage,
+	".ts":       javascriptSyntaxLanguage,
+	".tsx":      javascriptSyntaxLanguage,
+	".mts":      javascriptSyntaxLanguage,
+	".cts":      javascriptSyntaxLanguage,
+	".py":       pythonSyntaxLanguage,
+	".pyi":      pythonSyntaxLanguage,
+	".yaml":     yamlSyntaxLanguage,
+	".yml":      yamlSyntaxLanguage,
+	".json":     jsonSyntaxLanguage,
+	".md":       markdownSyntaxLanguage,
+	".markdown": markdownSyntaxLanguage,
+	".sh":       shellSyntaxLanguage,
+	".bash":     shellSyntaxLanguage,
+	".zsh":      shellSyntaxLanguage,
+}
+
+// return the language to highlight the file with based on its extension, nil if it is not supported
+func SyntaxLanguageOf(filePathName string) *SyntaxLanguage {
+	return syntaxLanguageByExtension[strings.ToLower(filepath.Ext(filePathName))]
+}
+
+func SyntaxTokenStyle(kind string) lipgloss.Style {
+	switch kind {
+	case SYNTAXKEYWORD:
+		return SyntaxKeywordStyle
+	case SYNTAXTYPE:
+		return SyntaxTypeStyle
+	case SYNTAXLITERAL:
+		return SyntaxLiteralStyle
+	case SYNTAXSTRING:
+		return SyntaxStringStyle
+	case SYNTAXNUMBER:
+		return SyntaxNumberStyle
+	case SYNTAXCOMMENT:
+		return SyntaxCommentStyle
+	case SYNTAXFUNCTION:
+		return SyntaxFunctionStyle
+	case SYNTAXKEY:
+		return SyntaxKeyStyle
+	case SYNTAXVARIABLE:
+		return SyntaxVariableStyle
+	case SYNTAXHEADING:
+		return SyntaxHeadingStyle
+	}
+	return SyntaxPlainStyle
+}
+
+// ----------------------------------
+//
+//	Split a single line of code into tokens
+//	* the text of all the tokens joined together will always be the same as the line
+//
+// ----------------------------------
+func TokenizeSyntax(line string, language *SyntaxLanguage) []SyntaxToken {
+	if language == nil {
+		return []SyntaxToken{{Text: line, Kind: SYNTAXPLAIN}}
+	}
+
+	tokens := []SyntaxToken{}
+	appendToken := func(text string, kind string) {
+		if text == "" {
+			return
+		}
+		if len(tokens) > 0 && tokens[len(tokens)-1].Kind == kind {
+			tokens[len(tokens)-1].Text += text
+			return
+		}
+		tokens = append(tokens, SyntaxToken{Text: text, Kind: kind})
+	}
+
+	rest := line
+	if language.IsMarkdown {
+		if markdownHeadingRegex.MatchString(rest) {
+			appendToken(rest, SYNTAXHEADING)
+			return tokens
+		}
+		if match := markdownListMarkerRegex.FindStringSubmatch(rest); match != nil {
+			appendToken(match[1], SYNTAXPLAIN)
+			appendToken(match[2], SYNTAXKEYWORD)
+			rest = rest[len(match[1])+len(match[2]):]
+		}
+	}
+	if language.HasKeys && language.Name == "yaml" {
+		if match := yamlKeyRegex.FindStringSubmatch(rest); match != nil {
+			appendToken(match[1], SYNTAXPLAIN)
+			appendToken(match[2], SYNTAXKEY)
+			appendToken(match[3], SYNTAXPLAIN)
+			rest = rest[len(match[1])+len(match[2])+len(match[3]):]
+		}
+	}
+	// the continuation of a block comment (eg, ` * doc`), as the line before it is not known
+	if language.BlockCommentStart != "" {
+		trimmed := strings.TrimLeft(rest, " \t")
+		if trimmed == "*" || strings.HasPrefix(trimmed, "* ") || strings.HasPrefix(trimmed, language.BlockCommentEnd) {
+			appendToken(rest, SYNTAXCOMMENT)
+			return tokens
+		}
+	}
+
+	for rest != "" {
+		// comment
+		if language.BlockCommentStart != "" && strings.HasPrefix(rest, language.BlockCommentStart) {
+			end := strings.Index(rest[len(language.BlockCommentStart):], language.BlockCommentEnd)
+			if end < 0 {
+				appendToken(rest, SYNTAXCOMMENT)
+				return tokens
+			}
+			end += len(language.BlockCommentStart) + len(language.BlockCommentEnd)
+			appendToken(rest[:end], SYNTAXCOMMENT)
+			rest = rest[end:]
+			continue
+		}
+		if isLineCommentStart(line, rest, language) {
+			appendToken(rest, SYNTAXCOMMENT)
+			return tokens
+		}
+
+		r, runeLength := utf8.DecodeRuneInString(rest)
+		switch {
+		case strings.ContainsRune(language.StringDelimiters, r):
+			end := stringLiteralEnd(rest, r)
+			kind := SYNTAXSTRING
+			if language.HasKeys && strings.HasPrefix(strings.TrimLeft(rest[end:], " \t"), ":") {
+				kind = SYNTAXKEY
+			}
+			appendToken(rest[:end], kind)
+			rest = rest[end:]
+		case language.HasVariables && r == '$':
+			end := shellVariableEnd(rest)
+			appendToken(rest[:end], SYNTAXVARIABLE)
+			rest = rest[end:]
+		case unicode.IsDigit(r) && !endsWithWordRune(line[:len(line)-len(rest)], language):
+			end := 0
+			for end < len(rest) {
+				nextRune, nextRuneLength := utf8.DecodeRuneInString(rest[end:])
+				if !isWordRune(nextRune, language) && nextRune != '.' {
+					break
+				}
+				end += nextRuneLength
+			}
+			appendToken(rest[:end], SYNTAXNUMBER)
+			rest = rest[end:]
+		case isWordRune(r, language):
+			end := 0
+			for end < len(rest) {
+				nextRune, nextRuneLength := utf8.DecodeRuneInString(rest[end:])
+				if !isWordRune(nextRune, language) {
+					break
+				}
+				end += nextRuneLength
+			}
+			word := rest[:end]
+			appendToken(word, syntaxWordKind(word, rest[end:], language))
+			rest = rest[end:]
+		default:
+			appendToken(rest[:runeLength], SYNTAXPLAIN)
+			rest = rest[runeLength:]
+		}
+	}
+	return tokens
+}
+
+func syntaxWordKind(word string, after string, language *SyntaxLanguage) string {
+	switch {
+	case language.Keywords[word]:
+		return SYNTAXKEYWORD
+	case language.Types[word]:
+		return SYNTAXTYPE
+	case language.Literals[word]:
+		return SYNTAXLITERAL
+	case !language.HasKeys && !language.IsMarkdown && strings.HasPrefix(after, "("):
+		return SYNTAXFUNCTION
+	}
+	return SYNTAXPLAIN
+}
+
+// a "#" comment only start at the start of the line or after a whitespace (eg, `${#array[@]}` is not a comment)
+func isLineCommentStart(line string, rest string, language *SyntaxLanguage) bool {
+	for _, prefix := range language.LineCommentPrefixes {
+		if !strings.HasPrefix(rest, prefix) {
+			continue
+		}
+		if prefix != "#" {
+			return true
+		}
+		before := line[:len(line)-len(rest)]
+		if before == "" || strings.HasSuffix(before, " ") || strings.HasSuffix(before, "\t") {
+			return true
+		}
+	}
+	return false
+}
+
+// return the position right after the closing delimiter, or the end of line if it was not closed within the line
+func stringLiteralEnd(rest string, delimiter rune) int {
+	escaped := false
+	for index, r := range rest[1:] {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\' && delimiter != '`':
+			escaped = true
+		case r == delimiter:
+			return index + 2
+		}
+	}
+	return len(rest)
+}
+
+func shellVariableEnd(rest string) int {
+	if strings.HasPrefix(rest, "${") {
+		if end := strings.Index(rest, "}"); end > 0 {
+			return end + 1
+		}
+		return len(rest)
+	}
+	end := 1
+	for end < len(rest) {
+		nextRune, nextRuneLength := utf8.DecodeRuneInString(rest[end:])
+		if nextRune != '_' && !unicode.IsLetter(nextRune) && !unicode.IsDigit(nextRune) {
+			break
+		}
+		end += nextRuneLength
+	}
+	// special parameters, eg: $?, $@, $1
+	if end == 1 && len(rest) > 1 && strings.ContainsRune("?@#*$!-0123456789", rune(rest[1])) {
+		end = 2
+	}
+	return end
+}
+
+func isWordRune(r rune, language *SyntaxLanguage) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune(language.WordExtraRunes, r)
+}
+
+func endsWithWordRune(text string, language *SyntaxLanguage) bool {
+	if text == "" {
+		return false
+	}
+	lastRune, _ := utf8.DecodeLastRuneInString(text)
+	return isWordRune(lastRune, language)
+}
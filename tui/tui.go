@@ -11,8 +11,10 @@ import (
 	"github.com/gohyuhan/gitti/tui/constant"
 	"github.com/gohyuhan/gitti/tui/interaction"
 	"github.com/gohyuhan/gitti/tui/layout"
+	batchPopUp "github.com/gohyuhan/gitti/tui/popup/batch"
 	branchPopUp "github.com/gohyuhan/gitti/tui/popup/branch"
 	commitPopUp "github.com/gohyuhan/gitti/tui/popup/commit"
+	operationPopUp "github.com/gohyuhan/gitti/tui/popup/operation"
 	pullPopUp "github.com/gohyuhan/gitti/tui/popup/pull"
 	pushPopUp "github.com/gohyuhan/gitti/tui/popup/push"
 	stashPopUp "github.com/gohyuhan/gitti/tui/popup/stash"
@@ -38,42 +40,48 @@ func NewGittiAppModel(tuiUpdateChannel chan string, repoPath string, repoName st
 	vpTwo.MouseWheelDelta = 1
 
 	gittiModel := &types.GittiModel{
-		TuiUpdateChannel:                 tuiUpdateChannel,
-		UserSetEditor:                    settings.GITTICONFIGSETTINGS.Editor,
-		CurrentSelectedComponent:         constant.ModifiedFilesComponent,
-		CurrentSelectedComponentIndex:    2,
-		TotalComponentCount:              4,
-		RepoPath:                         repoPath,
-		RepoName:                         repoName,
-		CheckOutBranch:                   "",
-		RemoteSyncLocalState:             "",
-		RemoteSyncRemoteState:            "",
-		BranchUpStream:                   "",
-		TrackedUpstreamOrBranchIcon:      "",
-		Width:                            0,
-		Height:                           0,
-		WindowLeftPanelRatio:             settings.GITTICONFIGSETTINGS.LeftPanelWidthRatio,
-		CurrentRepoBranchesInfoList:      list.New([]list.Item{}, branchComponent.GitBranchItemDelegate{}, 0, 0),
-		CurrentRepoModifiedFilesInfoList: list.New([]list.Item{}, filesComponent.GitModifiedFilesItemDelegate{}, 0, 0),
-		CurrentRepoCommitLogInfoList:     list.New([]list.Item{}, commitlogComponent.GitCommitLogItemDelegate{}, 0, 0),
-		CurrentRepoStashInfoList:         list.New([]list.Item{}, stashComponent.GitStashItemDelegate{}, 0, 0),
-		DetailPanelParentComponent:       "",
-		DetailPanelViewport:              vp,
-		DetailPanelViewportOffset:        0,
-		DetailPanelTwoViewport:           vpTwo,
-		DetailPanelTwoViewportOffset:     0,
-		DetailComponentPanelLayout:       constant.HORIZONTAL,
-		ListNavigationIndexPosition:      types.GittiComponentsCurrentListNavigationIndexPosition{LocalBranchComponent: 0, ModifiedFilesComponent: 0, StashComponent: 0},
-		PopUpType:                        constant.NoPopUp,
-		PopUpModel:                       struct{}{},
-		GitOperations:                    gitOperations,
-		GlobalKeyBindingKeyMapLargestLen: 0,
+		TuiUpdateChannel:                  tuiUpdateChannel,
+		UserSetEditor:                     settings.GITTICONFIGSETTINGS.Editor,
+		CurrentSelectedComponent:          constant.ModifiedFilesComponent,
+		CurrentSelectedComponentIndex:     2,
+		TotalComponentCount:               4,
+		RepoPath:                          repoPath,
+		RepoName:                          repoName,
+		CheckOutBranch:                    "",
+		RemoteSyncLocalState:              "",
+		RemoteSyncRemoteState:             "",
+		BranchUpStream:                    "",
+		TrackedUpstreamOrBranchIcon:       "",
+		Width:                             0,
+		Height:                            0,
+		WindowLeftPanelRatio:              settings.GITTICONFIGSETTINGS.LeftPanelWidthRatio,
+		CurrentRepoBranchesInfoList:       list.New([]list.Item{}, branchComponent.GitBranchItemDelegate{}, 0, 0),
+		CurrentRepoModifiedFilesInfoList:  list.New([]list.Item{}, filesComponent.GitModifiedFilesItemDelegate{}, 0, 0),
+		CurrentRepoCommitLogInfoList:      list.New([]list.Item{}, commitlogComponent.GitCommitLogItemDelegate{}, 0, 0),
+		CurrentRepoCommitLogFilesInfoList: list.New([]list.Item{}, commitlogComponent.GitCommitLogFileItemDelegate{}, 0, 0),
+		CurrentRepoStashInfoList:          list.New([]list.Item{}, stashComponent.GitStashItemDelegate{}, 0, 0),
+		IsModifiedFilesTreeView:           false,
+		ModifiedFilesCollapsedDirectories: make(map[string]bool),
+		DetailPanelParentComponent:        "",
+		DetailPanelViewport:               vp,
+		DetailPanelViewportOffset:         0,
+		DetailPanelTwoViewport:            vpTwo,
+		DetailPanelTwoViewportOffset:      0,
+		DiffOptions:                       git.DefaultDiffOptions(),
+		IsSideBySideDiffView:              settings.GITTICONFIGSETTINGS.DiffViewMode == settings.DIFFVIEWMODESIDEBYSIDE,
+		DetailComponentPanelLayout:        constant.HORIZONTAL,
+		ListNavigationIndexPosition:       types.GittiComponentsCurrentListNavigationIndexPosition{LocalBranchComponent: 0, ModifiedFilesComponent: 0, StashComponent: 0},
+		PopUpType:                         constant.NoPopUp,
+		PopUpModel:                        struct{}{},
+		GitOperations:                     gitOperations,
+		GlobalKeyBindingKeyMapLargestLen:  0,
 	}
 	gittiModel.IsRenderInit.Store(false)
 	gittiModel.ShowPopUp.Store(false)
 	gittiModel.IsTyping.Store(false)
 	gittiModel.IsDetailComponentPanelInfoFetchProcessing.Store(false)
 	gittiModel.ShowDetailPanelTwo.Store(false)
+	gittiModel.IsDetailPanelSideBySide.Store(false)
 
 	return &GittiAppModel{model: gittiModel}
 }
@@ -107,11 +115,17 @@ func (gAM *GittiAppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		model, cmd := interaction.GittiKeyInteraction(msg, m)
 		gAM.model = model
+		layout.SyncSideBySideDetailPanelViewports(gAM.model)
 		return gAM, cmd
 	case GitUpdateMsg:
 		updateEvent := string(msg)
 		switch updateEvent {
 		case constant.DETAIL_COMPONENT_PANEL_UPDATED:
+			// the second detail panel might be gone after a refresh (eg, all unstaged hunks were staged)
+			if m.CurrentSelectedComponent == constant.DetailComponentTwo && !m.ShowDetailPanelTwo.Load() {
+				m.CurrentSelectedComponent = constant.DetailComponent
+				services.RefreshDetailPanelDiffCursorContent(m)
+			}
 			layout.UpdateDetailComponentViewportLayout(gAM.model)
 			return gAM, nil
 		case git.GIT_BRANCH_UPDATE:
@@ -121,9 +135,11 @@ func (gAM *GittiAppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		case git.GIT_FILES_STATUS_UPDATE:
 			needReinit := filesComponent.InitModifiedFilesList(m)
-			if m.CurrentSelectedComponent == constant.ModifiedFilesComponent {
+			if m.CurrentSelectedComponent == constant.ModifiedFilesComponent || m.DetailPanelParentComponent == constant.ModifiedFilesComponent {
 				services.FetchDetailComponentPanelInfoService(m, needReinit)
 			}
+		case constant.COMMIT_LOG_JUMP_LOADED:
+			services.CommitLogJumpLoadedService(m)
 		case git.GIT_LOG_UPDATE:
 			needReinit := commitlogComponent.InitGitCommitLogList(m)
 			if m.CurrentSelectedComponent == constant.CommitLogComponent {
@@ -144,13 +160,24 @@ func (gAM *GittiAppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			pullPopUp.UpdatePopUpGitPullOutputViewport(m)
 		case git.GIT_REMOTE_SYNC_STATUS_AND_UPSTREAM_UPDATE:
 			gAM.updateGitRemoteStatusSyncLineStringAndUpStream()
+		case git.GIT_REPO_OPERATION_STATE_UPDATE:
+			m.RepoOperationState = m.GitOperations.GitRepoOperation.RepoOperationState()
+		case git.GIT_REPO_OPERATION_OUTPUT_UPDATE:
+			operationPopUp.UpdatePopUpGitRepoOperationOutputViewport(m)
 		}
 		return gAM, nil
 	case types.EditorFinishedMsg:
 		return gAM, nil
+	case types.GitToolFinishedMsg:
+		services.GitToolFinishedService(m, msg)
+		return gAM, nil
+	case types.GitApplyPatchFinishedMsg:
+		services.GitApplyPatchFinishedService(m, msg)
+		return gAM, nil
 	case tea.MouseMsg:
 		model, cmd := interaction.GittiMouseInteraction(msg, m)
 		gAM.model = model
+		layout.SyncSideBySideDetailPanelViewports(gAM.model)
 		return gAM, cmd
 	}
 
@@ -205,6 +232,18 @@ func (gAM *GittiAppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				branchPopup.Spinner, cmd = branchPopup.Spinner.Update(msg)
 				cmds = append(cmds, cmd)
 			}
+		case constant.GitBatchOperationOutputPopUp:
+			if batchPopup, ok := m.PopUpModel.(*batchPopUp.GitBatchOperationOutputPopUpModel); ok && batchPopup.IsProcessing.Load() {
+				var cmd tea.Cmd
+				batchPopup.Spinner, cmd = batchPopup.Spinner.Update(msg)
+				cmds = append(cmds, cmd)
+			}
+		case constant.GitRepoOperationOutputPopUp:
+			if operationPopup, ok := m.PopUpModel.(*operationPopUp.GitRepoOperationOutputPopUpModel); ok && operationPopup.IsProcessing.Load() {
+				var cmd tea.Cmd
+				operationPopup.Spinner, cmd = operationPopup.Spinner.Update(msg)
+				cmds = append(cmds, cmd)
+			}
 		}
 	}
 	return gAM, tea.Batch(cmds...)
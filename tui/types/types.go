@@ -7,6 +7,7 @@ import (
 	"charm.land/bubbles/v2/list"
 	"charm.land/bubbles/v2/viewport"
 	"github.com/gohyuhan/gitti/api"
+	"github.com/gohyuhan/gitti/api/git"
 )
 
 type GittiModel struct {
@@ -23,6 +24,7 @@ type GittiModel struct {
 	RemoteSyncRemoteState                     string
 	BranchUpStream                            string
 	TrackedUpstreamOrBranchIcon               string
+	RepoOperationState                        git.RepoOperationState // the merge, rebase, cherry-pick, revert or bisect that the repo is in the middle of
 	Width                                     int
 	Height                                    int
 	WindowLeftPanelWidth                      int // this is the left part of the window
@@ -36,7 +38,14 @@ type GittiModel struct {
 	StashComponentPanelHeight                 int
 	CurrentRepoBranchesInfoList               list.Model
 	CurrentRepoModifiedFilesInfoList          list.Model
+	IsModifiedFilesTreeView                   bool            // show the modified files as a collapsible directory tree instead of a flat list
+	ModifiedFilesCollapsedDirectories         map[string]bool // the directories that were collapsed in tree view
 	CurrentRepoCommitLogInfoList              list.Model
+	IsCommitLogFilesView                      bool               // list the files changed by the commit within the commit log panel instead of the commit log
+	CommitLogFilesView                        CommitLogFilesView // the commit whose changed files are listed
+	CurrentRepoCommitLogFilesInfoList         list.Model
+	CommitLogJumpHash                         string // the commit to be selected once the commit log pages were loaded up to it (eg, jumping from blame)
+	CommitLogJumpNotFoundHash                 string // the commit that was jumped to but is not within the filtered or scoped commit log, it is noted on the title
 	CurrentRepoStashInfoList                  list.Model
 	DetailPanelParentComponent                string // this is to store the parent component that cause a move into the detail panel component, so that we can return back to the correct one
 	DetailPanelViewport                       viewport.Model
@@ -44,8 +53,17 @@ type GittiModel struct {
 	DetailPanelTwoViewport                    viewport.Model
 	DetailPanelTwoViewportOffset              int
 	ShowDetailPanelTwo                        atomic.Bool
+	DetailPanelDiffCursor                     *DetailPanelDiffCursor  // hunk/line cursor on the file diff shown in DetailPanelViewport, nil when it was not a file diff
+	DetailPanelTwoDiffCursor                  *DetailPanelDiffCursor  // hunk/line cursor on the file diff shown in DetailPanelTwoViewport
+	DetailPanelBlameCursor                    *DetailPanelBlameCursor // line cursor on the blame shown in DetailPanelViewport, nil when it was not a blame
+	IsBlameView                               bool                    // show the blame of the selected modified file instead of its diff
+	DetailPanelDiffPage                       DetailPanelDiffPage     // the page of a large file diff shown on the detail component panel
+	DiffOptions                               git.DiffOptions         // session level options on how the file, commit and stash diff are generated
+	IsSideBySideDiffView                      bool                    // show the diff with the old content on DetailPanelViewport and the new content on DetailPanelTwoViewport
+	IsDetailPanelSideBySide                   atomic.Bool             // the detail component panel is currently showing a side by side diff, both viewports scroll together
 	DetailComponentPanelLayout                string
 	ListNavigationIndexPosition               GittiComponentsCurrentListNavigationIndexPosition
+	ListMarkedItems                           GittiComponentsListMarkedItems // the items marked for batch operation
 	ShowPopUp                                 atomic.Bool
 	PopUpType                                 string
 	PopUpModel                                interface{}
@@ -68,6 +86,58 @@ type GittiComponentsCurrentListNavigationIndexPosition struct {
 	StashComponent         int
 }
 
+// ---------------------------------
+//
+// to record the items marked for batch operation within each list component,
+// keyed by the branch name, file path and stash hash
+//
+// ---------------------------------
+type GittiComponentsListMarkedItems struct {
+	LocalBranchComponent   map[string]bool
+	ModifiedFilesComponent map[string]bool
+	StashComponent         map[string]bool
+}
+
+// ---------------------------------
+//
+// to record the hunk or line cursor on a file diff shown within the detail component panel
+//
+// ---------------------------------
+type DetailPanelDiffCursor struct {
+	FileStatus           git.FileStatus
+	DiffType             string       // the diff type that was fetched, to decide if the selection should be staged or unstaged
+	Title                string       // the title rendered above the diff
+	DiffLines            []string     // the diff lines returned from git, nil when the file can't be previewed
+	HighlightedDiffLines []string     // DiffLines with the changed words highlighted, this is what will be rendered
+	Diff                 git.FileDiff // the parsed DiffLines
+	HunkIndex            int
+	LineIndex            int  // only used in line mode, point to an added or removed line within the current hunk
+	IsLineMode           bool // move and toggle line by line instead of hunk by hunk
+	IsReadOnly           bool // the diff was generated with options (eg, ignoring whitespace) that it can't be staged or discarded from
+}
+
+type DetailPanelBlameCursor struct {
+	FilePathName string
+	Title        string          // the title rendered above the blame
+	BlameLines   []git.BlameLine // nil when the file can't be blamed
+	LineIndex    int
+}
+
+// the commit whose changed files are listed within the commit log panel, and the parent that it is diffed against
+type CommitLogFilesView struct {
+	CommitHash   string
+	ParentHash   string // empty for a root commit, the files are then diffed against the empty tree
+	ParentNumber int    // the 1 based parent number of a merge commit, 0 when it is not a merge commit
+}
+
+// the diff of a large file is streamed page by page instead of being loaded as a whole
+type DetailPanelDiffPage struct {
+	FilePathName string // the file that the page belong to, the page will restart from the first when another file was selected
+	Page         int
+	IsPaged      bool // the current diff shown is a paged diff
+	HasNextPage  bool
+}
+
 // ---------------------------------
 //
 // # A bubbletea message to indicate that the editor has quit or close (apply only for terminal editor, external GUI like vscode/zed/cursor etc will not need this)
@@ -76,3 +146,24 @@ type GittiComponentsCurrentListNavigationIndexPosition struct {
 type EditorFinishedMsg struct {
 	Err error
 }
+
+// ---------------------------------
+//
+// # A bubbletea message to indicate that the git merge tool or diff tool has quit or close
+//
+// ---------------------------------
+type GitToolFinishedMsg struct {
+	FilePathName string
+	IsMergeTool  bool
+	IsResolved   bool // the conflict of the file was resolved by the merge tool, only for merge tool
+	Err          error
+}
+
+// ---------------------------------
+//
+// # A bubbletea message to indicate that the hunk/line changes were staged, unstaged or discarded
+//
+// ---------------------------------
+type GitApplyPatchFinishedMsg struct {
+	Err error // the changes could not be applied (eg, the patch does not apply)
+}